@@ -25,14 +25,32 @@ import (
 
 	"github.com/gontainer/grouperror"
 	"github.com/gontainer/reflectpro/caller/internal/caller"
+	"github.com/gontainer/reflectpro/converter"
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
 )
 
 // Call calls the given function with the given arguments.
 // It returns values returned by the function in a slice.
 // If the third argument equals true, it converts types whenever it is possible.
-//
+func Call(fn any, args []any, convertArgs bool) ([]any, error) {
+	return call(fn, args, intReflect.ConvertOptions(convertArgs))
+}
+
+/*
+CallWith works similar to [Call], but it accepts options of the conversion.
+The given options are applied on top of the defaults, see [converter.SetDefaults].
+It converts arguments whenever possible, unless [converter.AssignOnly] is given.
+
+	fn := func(age uint8) {}
+	_, err := caller.CallWith(fn, []any{300}, converter.Strict())
+	fmt.Println(err) // cannot call func(uint8): arg0: cannot convert int to uint8: value 300 overflows uint8
+*/
+func CallWith(fn any, args []any, opts ...converter.Option) ([]any, error) {
+	return call(fn, args, intReflect.NewOptions(opts...))
+}
+
 //nolint:wrapcheck
-func Call(fn any, args []any, convertArgs bool) (_ []any, err error) {
+func call(fn any, args []any, opts intReflect.Options) (_ []any, err error) {
 	defer func() {
 		if err != nil {
 			err = grouperror.Prefix(fmt.Sprintf("cannot call %T: ", fn), err)
@@ -44,7 +62,7 @@ func Call(fn any, args []any, convertArgs bool) (_ []any, err error) {
 		return nil, err
 	}
 
-	return caller.CallFunc(v, args, convertArgs)
+	return caller.CallFunc(v, args, opts)
 }
 
 const (
@@ -79,8 +97,33 @@ See [ProviderError].
 
 	db, executed, err := caller.CallProvider(p, nil, false)
 */
-//nolint:wrapcheck
 func CallProvider(provider any, args []any, convertArgs bool) (_ any, executed bool, err error) { //nolint:ireturn
+	return callProvider(provider, args, intReflect.ConvertOptions(convertArgs))
+}
+
+// CallProviderWith works similar to [CallProvider], but it accepts options of the conversion, see [CallWith].
+func CallProviderWith( //nolint:ireturn
+	provider any,
+	args []any,
+	opts ...converter.Option,
+) (
+	_ any,
+	executed bool,
+	err error,
+) {
+	return callProvider(provider, args, intReflect.NewOptions(opts...))
+}
+
+//nolint:wrapcheck
+func callProvider( //nolint:ireturn
+	provider any,
+	args []any,
+	opts intReflect.Options,
+) (
+	_ any,
+	executed bool,
+	err error,
+) {
 	defer func() {
 		if !executed && err != nil {
 			err = grouperror.Prefix(fmt.Sprintf(providerInternalErrPrefix, provider), err)
@@ -96,7 +139,7 @@ func CallProvider(provider any, args []any, convertArgs bool) (_ any, executed b
 		return nil, false, err
 	}
 
-	results, err := caller.CallFunc(fn, args, convertArgs)
+	results, err := caller.CallFunc(fn, args, opts)
 	if err != nil {
 		return nil, false, err
 	}
@@ -133,7 +176,35 @@ func CallProviderMethod( //nolint:ireturn
 	executed bool,
 	err error,
 ) {
-	results, err := caller.CallMethod(object, method, args, convertArgs, caller.ValidatorProvider)
+	return callProviderMethod(object, method, args, intReflect.ConvertOptions(convertArgs))
+}
+
+// CallProviderMethodWith works similar to [CallProviderMethod],
+// but it accepts options of the conversion, see [CallWith].
+func CallProviderMethodWith( //nolint:ireturn
+	object any,
+	method string,
+	args []any,
+	opts ...converter.Option,
+) (
+	_ any,
+	executed bool,
+	err error,
+) {
+	return callProviderMethod(object, method, args, intReflect.NewOptions(opts...))
+}
+
+func callProviderMethod( //nolint:ireturn
+	object any,
+	method string,
+	args []any,
+	opts intReflect.Options,
+) (
+	_ any,
+	executed bool,
+	err error,
+) {
+	results, err := caller.CallMethod(object, method, args, opts, caller.ValidatorProvider)
 	if err != nil {
 		//nolint:wrapcheck
 		return nil, false, grouperror.Prefix(fmt.Sprintf(providerMethodInternalErrPrefix, object, method), err)
@@ -172,7 +243,13 @@ CallMethod works similar to [Call] with the difference it calls the method by th
 	}
 */
 func CallMethod(object any, method string, args []any, convertArgs bool) (_ []any, err error) {
-	return caller.CallMethod(object, method, args, convertArgs, caller.DontValidate) //nolint:wrapcheck
+	//nolint:wrapcheck
+	return caller.CallMethod(object, method, args, intReflect.ConvertOptions(convertArgs), caller.DontValidate)
+}
+
+// CallMethodWith works similar to [CallMethod], but it accepts options of the conversion, see [CallWith].
+func CallMethodWith(object any, method string, args []any, opts ...converter.Option) (_ []any, err error) {
+	return caller.CallMethod(object, method, args, intReflect.NewOptions(opts...), caller.DontValidate) //nolint:wrapcheck
 }
 
 /*
@@ -194,13 +271,27 @@ CallWither works similar to [CallMethod] with the difference the method must be
 	}
 */
 func CallWither(object any, wither string, args []any, convertArgs bool) (_ any, err error) { //nolint:ireturn
+	return callWither(object, wither, args, intReflect.ConvertOptions(convertArgs))
+}
+
+// CallWitherWith works similar to [CallWither], but it accepts options of the conversion, see [CallWith].
+func CallWitherWith( //nolint:ireturn
+	object any,
+	wither string,
+	args []any,
+	opts ...converter.Option,
+) (_ any, err error) {
+	return callWither(object, wither, args, intReflect.NewOptions(opts...))
+}
+
+func callWither(object any, wither string, args []any, opts intReflect.Options) (_ any, err error) { //nolint:ireturn
 	defer func() {
 		if err != nil {
 			err = grouperror.Prefix(fmt.Sprintf("cannot call wither (%T).%+q: ", object, wither), err)
 		}
 	}()
 
-	results, err := caller.CallMethod(object, wither, args, convertArgs, caller.ValidatorWither)
+	results, err := caller.CallMethod(object, wither, args, opts, caller.ValidatorWither)
 	if err != nil {
 		return nil, err //nolint:wrapcheck
 	}
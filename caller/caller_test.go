@@ -24,11 +24,13 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
 	errAssert "github.com/gontainer/grouperror/assert"
 	"github.com/gontainer/reflectpro/caller"
+	"github.com/gontainer/reflectpro/converter"
 	"github.com/gontainer/reflectpro/getter"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -49,6 +51,37 @@ func TestCall(t *testing.T) {
 		assert.Equal(t, "Mary", p.name)
 	})
 
+	t.Run("Conversion error", func(t *testing.T) {
+		t.Parallel()
+
+		fn := func(a int, b []int) {}
+		_, err := caller.Call(fn, []any{1, []any{2, "three"}}, true)
+		require.Error(t, err)
+
+		var convErr *converter.ConversionError
+		require.True(t, errors.As(err, &convErr))
+		assert.Equal(t, "[1]", convErr.Path().String())
+		assert.Equal(t, reflect.TypeOf(""), convErr.Innermost().From)
+		assert.Equal(t, reflect.TypeOf(0), convErr.Innermost().To)
+	})
+
+	t.Run("Struct of a wrong type", func(t *testing.T) {
+		t.Parallel()
+
+		type person struct {
+			Name string
+		}
+
+		fn := func(p person) {}
+		_, err := caller.Call(fn, []any{struct{ ID int }{ID: 1}}, true)
+		assert.EqualError(
+			t,
+			err,
+			"cannot call func(caller_test.person): arg0: cannot convert struct { ID int } to caller_test.person: "+
+				"no fields of struct { ID int } match fields of caller_test.person",
+		)
+	})
+
 	t.Run("Given invalid functions", func(t *testing.T) {
 		t.Parallel()
 
@@ -107,7 +140,8 @@ func TestCall(t *testing.T) {
 
 		expected := []string{
 			"cannot call func([]int, *int): arg0: cannot convert struct {} to []int",
-			"cannot call func([]int, *int): arg1: cannot convert string to *int",
+			`cannot call func([]int, *int): arg1: cannot convert string to *int: ` +
+				`cannot convert string to int: parsing "*int": invalid syntax`,
 		}
 		errAssert.EqualErrorGroup(t, err, expected)
 	})
@@ -1058,3 +1092,50 @@ func TestCallWither_errors(t *testing.T) {
 		})
 	}
 }
+
+func TestCallWith(t *testing.T) {
+	t.Parallel()
+
+	t.Run("AssignOnly", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := caller.CallWith(func(uint) {}, []any{5}, converter.AssignOnly())
+		assert.EqualError(t, err, "cannot call func(uint): arg0: value of type int is not assignable to type uint")
+
+		r, err := caller.CallWith(func(u uint) uint { return u }, []any{5})
+		require.NoError(t, err)
+		assert.Equal(t, []any{uint(5)}, r)
+	})
+	t.Run("Provider", func(t *testing.T) {
+		t.Parallel()
+
+		r, executed, err := caller.CallProviderWith(func(i int) int { return i }, []any{nil}, converter.NilAsZero())
+		require.NoError(t, err)
+		assert.True(t, executed)
+		assert.Equal(t, 0, r)
+	})
+	t.Run("Methods", func(t *testing.T) {
+		t.Parallel()
+
+		p := &person{}
+		_, err := caller.CallMethodWith(p, "SetName", []any{[]byte("Mary")})
+		require.NoError(t, err)
+		assert.Equal(t, "Mary", p.name)
+
+		_, err = caller.CallMethodWith(p, "SetName", []any{[]byte("Jane")}, converter.AssignOnly())
+		assert.EqualError(
+			t,
+			err,
+			`cannot call method (*caller_test.person)."SetName": arg0: value of type []uint8 is not assignable to type string`,
+		)
+
+		r, err := caller.CallWitherWith(person{}, "WithName", []any{[]byte("Mary")})
+		require.NoError(t, err)
+		assert.Equal(t, person{name: "Mary"}, r)
+
+		r, executed, err := caller.CallProviderMethodWith(person{name: "Jane"}, "Clone", nil, converter.Strict())
+		require.NoError(t, err)
+		assert.True(t, executed)
+		assert.Equal(t, person{name: "Jane"}, r)
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package caller_test
+
+import (
+	"fmt"
+
+	"github.com/gontainer/reflectpro/caller"
+)
+
+func ExampleCallAs1() {
+	double := func(i int) int {
+		return i * 2
+	}
+	r, err := caller.CallAs1[string](double, []any{"21"})
+	fmt.Printf("%q %v\n", r, err)
+	// Output: "42" <nil>
+}
+
+func ExampleCallProviderAs() {
+	p := func() (any, error) {
+		return []any{"80", 443}, nil
+	}
+	ports, executed, err := caller.CallProviderAs[[]uint16](p, nil)
+	fmt.Println(ports, executed, err)
+	// Output: [80 443] true <nil>
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"github.com/gontainer/reflectpro/caller"
+	"github.com/gontainer/reflectpro/converter"
 )
 
 type Person struct {
@@ -65,12 +66,46 @@ func ExampleCall_error() {
 	fn := func(a int, b int) int {
 		return a * b
 	}
-	_, err := caller.Call(fn, []any{"2", "2"}, true)
+	_, err := caller.Call(fn, []any{"2", "two"}, true)
 
 	fmt.Println(err)
 	// Output:
-	// cannot call func(int, int) int: arg0: cannot convert string to int
-	// cannot call func(int, int) int: arg1: cannot convert string to int
+	// cannot call func(int, int) int: arg1: cannot convert string to int: parsing "two": invalid syntax
+}
+
+func ExampleCall_convertStrings() {
+	fn := func(a int, b float64, verbose bool) string {
+		return fmt.Sprintf("%d %.2f %t", a, b, verbose)
+	}
+	r, _ := caller.Call(fn, []any{"2", "1e-2", "yes"}, true)
+	fmt.Println(r[0])
+	// Output: 2 0.01 true
+}
+
+func ExampleCall_pointers() {
+	type Config struct {
+		Name string
+	}
+
+	fn := func(c *Config, n int) string {
+		return fmt.Sprintf("%s %d", c.Name, n)
+	}
+	n := 5
+	r, _ := caller.Call(fn, []any{Config{Name: "app"}, &n}, true)
+	fmt.Println(r[0])
+	// Output: app 5
+}
+
+func ExampleCall_func() {
+	apply := func(fn func(int64) int64, v int64) int64 {
+		return fn(v)
+	}
+	double := func(i int) int {
+		return i * 2
+	}
+	r, _ := caller.Call(apply, []any{double, 21}, true)
+	fmt.Println(r[0])
+	// Output: 42
 }
 
 func ExampleCall_error2() {
@@ -110,3 +145,10 @@ func ExampleCallWither() {
 	fmt.Printf("%+v\n", p2)
 	// Output: {name:Mary age:30}
 }
+
+func ExampleCallWith() {
+	fn := func(age uint8) {}
+	_, err := caller.CallWith(fn, []any{300}, converter.Strict())
+	fmt.Println(err)
+	// Output: cannot call func(uint8): arg0: cannot convert int to uint8: value 300 overflows uint8
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package caller
+
+import (
+	"fmt"
+
+	"github.com/gontainer/grouperror"
+	"github.com/gontainer/reflectpro/caller/internal/caller"
+	"github.com/gontainer/reflectpro/converter"
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+)
+
+/*
+CallAs1 works similar to [CallWith], but it requires a function that returns exactly one value,
+and it converts that value to the type R.
+Use [errors.As] to get [converter.ConversionError] whenever the value cannot be converted.
+
+	double := func(i int) int {
+		return i * 2
+	}
+	r, _ := caller.CallAs1[string](double, []any{"21"})
+	fmt.Printf("%q\n", r)
+	// Output: "42"
+*/
+func CallAs1[R any](fn any, args []any, opts ...converter.Option) (r R, err error) {
+	defer func() {
+		if err != nil {
+			err = grouperror.Prefix(fmt.Sprintf("cannot call %T: ", fn), err)
+		}
+	}()
+
+	o := intReflect.NewOptions(opts...)
+
+	results, err := callFuncN(fn, args, 1, o)
+	if err != nil {
+		return r, err
+	}
+
+	return resultAs[R](results, 0, o)
+}
+
+// CallAs2 works similar to [CallAs1], but it requires a function that returns exactly two values.
+func CallAs2[R1, R2 any](fn any, args []any, opts ...converter.Option) (r1 R1, r2 R2, err error) {
+	defer func() {
+		if err != nil {
+			err = grouperror.Prefix(fmt.Sprintf("cannot call %T: ", fn), err)
+		}
+	}()
+
+	o := intReflect.NewOptions(opts...)
+
+	results, err := callFuncN(fn, args, 2, o) //nolint:gomnd
+	if err != nil {
+		return r1, r2, err
+	}
+
+	if r1, err = resultAs[R1](results, 0, o); err != nil {
+		return r1, r2, err
+	}
+
+	r2, err = resultAs[R2](results, 1, o)
+
+	return r1, r2, err
+}
+
+/*
+CallProviderAs works similar to [CallProviderWith], but it converts the value returned by the provider to the type T.
+Whenever the value cannot be converted, "executed" equals false,
+because the error has not been returned by the provider.
+
+	p := func() (any, error) {
+		return []any{"80", 443}, nil
+	}
+	ports, _, _ := caller.CallProviderAs[[]uint16](p, nil)
+	fmt.Println(ports)
+	// Output: [80 443]
+*/
+func CallProviderAs[T any](provider any, args []any, opts ...converter.Option) (r T, executed bool, err error) {
+	o := intReflect.NewOptions(opts...)
+
+	v, executed, err := callProvider(provider, args, o)
+	if err != nil {
+		return r, executed, err
+	}
+
+	r, err = resultAs[T]([]any{v}, 0, o)
+	if err != nil {
+		//nolint:wrapcheck
+		return r, false, grouperror.Prefix(fmt.Sprintf(providerInternalErrPrefix, provider), err)
+	}
+
+	return r, true, nil
+}
+
+// CallProviderMethodAs works similar to [CallProviderAs], but the provider must be a method on the given object.
+func CallProviderMethodAs[T any](
+	object any,
+	method string,
+	args []any,
+	opts ...converter.Option,
+) (
+	r T,
+	executed bool,
+	err error,
+) {
+	o := intReflect.NewOptions(opts...)
+
+	v, executed, err := callProviderMethod(object, method, args, o)
+	if err != nil {
+		return r, executed, err
+	}
+
+	r, err = resultAs[T]([]any{v}, 0, o)
+	if err != nil {
+		//nolint:wrapcheck
+		return r, false, grouperror.Prefix(fmt.Sprintf(providerMethodInternalErrPrefix, object, method), err)
+	}
+
+	return r, true, nil
+}
+
+// CallWitherAs works similar to [CallWitherWith], but it converts the value returned by the wither to the type T.
+func CallWitherAs[T any](object any, wither string, args []any, opts ...converter.Option) (r T, err error) {
+	o := intReflect.NewOptions(opts...)
+
+	v, err := callWither(object, wither, args, o)
+	if err != nil {
+		return r, err
+	}
+
+	r, err = resultAs[T]([]any{v}, 0, o)
+	if err != nil {
+		//nolint:wrapcheck
+		return r, grouperror.Prefix(fmt.Sprintf("cannot call wither (%T).%+q: ", object, wither), err)
+	}
+
+	return r, nil
+}
+
+// callFuncN calls the given function, it must return exactly n values.
+//
+//nolint:wrapcheck
+func callFuncN(fn any, args []any, n int, opts intReflect.Options) ([]any, error) {
+	v, err := caller.Func(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := caller.ValidatorResults(n).Validate(v); err != nil {
+		return nil, err
+	}
+
+	return caller.CallFunc(v, args, opts)
+}
+
+func resultAs[T any](results []any, i int, opts intReflect.Options) (T, error) {
+	r, err := intReflect.As[T](results[i], opts)
+	if err != nil {
+		//nolint:wrapcheck
+		return r, grouperror.Prefix(fmt.Sprintf("result%d: ", i), err)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package caller_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gontainer/reflectpro/caller"
+	"github.com/gontainer/reflectpro/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallAs1(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := caller.CallAs1[string](func(i int) int { return i * 2 }, []any{"21"})
+		require.NoError(t, err)
+		assert.Equal(t, "42", r)
+	})
+	t.Run("Conversion error", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := caller.CallAs1[uint8](func() int { return 300 }, nil, converter.Strict())
+		assert.EqualError(t, err, "cannot call func() int: result0: cannot convert int to uint8: value 300 overflows uint8")
+		assert.Zero(t, r)
+
+		var convErr *converter.ConversionError
+		require.True(t, errors.As(err, &convErr))
+		assert.Equal(t, reflect.TypeOf(uint8(0)), convErr.To)
+	})
+	t.Run("Invalid number of results", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := caller.CallAs1[int](func() (int, int) { return 1, 2 }, nil)
+		assert.EqualError(
+			t,
+			err,
+			"cannot call func() (int, int): function must return 1 value(s), given function returns 2 values",
+		)
+	})
+}
+
+func TestCallAs2(t *testing.T) {
+	t.Parallel()
+
+	divmod := func(a, b int) (int, int) {
+		return a / b, a % b
+	}
+
+	q, r, err := caller.CallAs2[string, uint](divmod, []any{7, 2})
+	require.NoError(t, err)
+	assert.Equal(t, "3", q)
+	assert.Equal(t, uint(1), r)
+
+	_, _, err = caller.CallAs2[int, []int](divmod, []any{7, 2})
+	assert.EqualError(t, err, "cannot call func(int, int) (int, int): result1: cannot convert int to []int")
+}
+
+func TestCallProviderAs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		p := func() (any, error) {
+			return []any{"80", 443}, nil
+		}
+		r, executed, err := caller.CallProviderAs[[]uint16](p, nil)
+		require.NoError(t, err)
+		assert.True(t, executed)
+		assert.Equal(t, []uint16{80, 443}, r)
+	})
+	t.Run("Provider error", func(t *testing.T) {
+		t.Parallel()
+
+		p := func() (any, error) {
+			return nil, errors.New("my error")
+		}
+		_, executed, err := caller.CallProviderAs[int](p, nil)
+		assert.EqualError(t, err, "provider returned error: my error")
+		assert.True(t, executed)
+	})
+	t.Run("Conversion error", func(t *testing.T) {
+		t.Parallel()
+
+		p := func() any {
+			return "five"
+		}
+		_, executed, err := caller.CallProviderAs[int](p, nil)
+		assert.EqualError(
+			t,
+			err,
+			`cannot call provider func() interface {}: result0: cannot convert string to int: parsing "five": invalid syntax`,
+		)
+		assert.False(t, executed)
+	})
+	t.Run("Method", func(t *testing.T) {
+		t.Parallel()
+
+		r, executed, err := caller.CallProviderMethodAs[person](&person{name: "Mary"}, "Clone", nil)
+		require.NoError(t, err)
+		assert.True(t, executed)
+		assert.Equal(t, person{name: "Mary"}, r)
+
+		_, executed, err = caller.CallProviderMethodAs[int](&person{name: "Mary"}, "Clone", nil)
+		assert.EqualError(
+			t,
+			err,
+			`cannot call provider (*caller_test.person)."Clone": result0: cannot convert caller_test.person to int`,
+		)
+		assert.False(t, executed)
+	})
+}
+
+func TestCallWitherAs(t *testing.T) {
+	t.Parallel()
+
+	r, err := caller.CallWitherAs[person](person{}, "WithName", []any{"Mary"})
+	require.NoError(t, err)
+	assert.Equal(t, person{name: "Mary"}, r)
+
+	p, err := caller.CallWitherAs[*person](person{}, "WithName", []any{"Mary"})
+	require.NoError(t, err)
+	assert.Equal(t, &person{name: "Mary"}, p)
+
+	_, err = caller.CallWitherAs[[]person](person{}, "WithName", []any{"Mary"})
+	assert.EqualError(
+		t,
+		err,
+		`cannot call wither (caller_test.person)."WithName": result0: `+
+			`cannot convert caller_test.person to []caller_test.person`,
+	)
+}
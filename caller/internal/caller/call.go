@@ -32,7 +32,7 @@ import (
 // CallFunc calls the given func.
 //
 // fn.Kind() MUST BE equal to [reflect.Func].
-func CallFunc(fn reflect.Value, args []any, convertArgs bool) ([]any, error) {
+func CallFunc(fn reflect.Value, args []any, opts intReflect.Options) ([]any, error) {
 	fnType := reflectType{fn.Type()}
 
 	if len(args) > fnType.NumIn() && !fnType.IsVariadic() {
@@ -59,7 +59,7 @@ func CallFunc(fn reflect.Value, args []any, convertArgs bool) ([]any, error) {
 			err       error
 		)
 
-		argsVals[i], err = intReflect.ValueOf(p, convertTo, convertArgs)
+		argsVals[i], err = intReflect.ValueOfWith(p, convertTo, opts)
 
 		if err != nil {
 			errs = append(errs, grouperror.Prefix(fmt.Sprintf("arg%d: ", i), err))
@@ -88,7 +88,7 @@ func CallMethod(
 	object any,
 	method string,
 	args []any,
-	convertArgs bool,
+	opts intReflect.Options,
 	validator FuncValidator,
 ) (
 	_ []any,
@@ -103,7 +103,7 @@ func CallMethod(
 	fn, err := Method(object, method)
 	if err != nil {
 		if errors.Is(err, ErrInvalidMethod) && isPtr(object) {
-			return validateAndForceCallMethod(object, method, args, convertArgs, validator)
+			return validateAndForceCallMethod(object, method, args, opts, validator)
 		}
 
 		return nil, err
@@ -115,20 +115,20 @@ func CallMethod(
 		}
 	}
 
-	return CallFunc(fn, args, convertArgs)
+	return CallFunc(fn, args, opts)
 }
 
 // validateAndCallFunc validates and calls the given func.
 //
 // fn.Kind() MUST BE equal to [reflect.Func].
-func validateAndCallFunc(fn reflect.Value, args []any, convertArgs bool, v FuncValidator) ([]any, error) {
+func validateAndCallFunc(fn reflect.Value, args []any, opts intReflect.Options, v FuncValidator) ([]any, error) {
 	if v != nil {
 		if err := v.Validate(fn); err != nil {
 			return nil, err //nolint:wrapcheck
 		}
 	}
 
-	return CallFunc(fn, args, convertArgs)
+	return CallFunc(fn, args, opts)
 }
 
 //nolint:wrapcheck,cyclop
@@ -136,7 +136,7 @@ func validateAndForceCallMethod(
 	object any,
 	method string,
 	args []any,
-	convertArgs bool,
+	opts intReflect.Options,
 	v FuncValidator,
 ) (
 	[]any,
@@ -176,7 +176,7 @@ func validateAndForceCallMethod(
 			return nil, err
 		}
 
-		return validateAndCallFunc(fn, args, convertArgs, v)
+		return validateAndCallFunc(fn, args, opts, v)
 	}
 
 	if len(chain) == 3 && chain.Prefixed(reflect.Ptr, reflect.Interface) {
@@ -192,7 +192,7 @@ func validateAndForceCallMethod(
 			return nil, err
 		}
 
-		res, err := validateAndCallFunc(fn, args, convertArgs, v)
+		res, err := validateAndCallFunc(fn, args, opts, v)
 		if err == nil {
 			val.Elem().Set(cp.Elem())
 		}
@@ -78,3 +78,14 @@ func validateProvider(fn reflect.Value) error {
 
 	return nil
 }
+
+// ValidatorResults validates the number of values returned by the function.
+func ValidatorResults(n int) FuncValidator {
+	return ChainValidator{func(fn reflect.Value) error {
+		if fn.Type().NumOut() != n {
+			return fmt.Errorf("function must return %d value(s), given function returns %d values", n, fn.Type().NumOut())
+		}
+
+		return nil
+	}}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package converter
+
+import (
+	"reflect"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+)
+
+// Verdict tells whether values of one type can be converted to another one, see [CanConvert].
+type Verdict uint8
+
+const (
+	// No means that values of the given type cannot be converted.
+	No = Verdict(intReflect.No)
+	// Maybe means that the result depends on the value, e.g. on the content of a string,
+	// or on the dynamic type of an interface.
+	Maybe = Verdict(intReflect.Maybe)
+	// Yes means that all values of the given type can be converted.
+	Yes = Verdict(intReflect.Yes)
+)
+
+func (v Verdict) String() string {
+	return intReflect.Verdict(v).String()
+}
+
+/*
+CanConvert checks ahead of time whether values of the type `from` can be converted to the type `to`.
+It returns [Maybe] whenever the result depends on the value, e.g. for elements of type any,
+and a human-readable reason for verdicts other than [Yes].
+The given options are applied on top of the defaults, see [SetDefaults].
+
+	verdict, reason := converter.CanConvert(reflect.TypeOf([]any{}), reflect.TypeOf([]int{}))
+	fmt.Println(verdict) // maybe
+	fmt.Println(reason)  // element: the dynamic type of interface {} is known at runtime only
+
+Converters registered by [Register] cannot be inspected without a value, so they may turn [No] into [Maybe].
+*/
+func CanConvert(from, to reflect.Type, opts ...Option) (_ Verdict, reason string) {
+	v, reason := intReflect.CanConvert(from, to, intReflect.NewOptions(opts...))
+
+	return Verdict(v), reason
+}
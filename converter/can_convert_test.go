@@ -0,0 +1,88 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package converter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gontainer/reflectpro/converter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanConvert(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Options", func(t *testing.T) {
+		t.Parallel()
+
+		verdict, reason := converter.CanConvert(reflect.TypeOf(int64(0)), reflect.TypeOf(uint8(0)))
+		assert.Equal(t, converter.Yes, verdict)
+		assert.Empty(t, reason)
+
+		verdict, reason = converter.CanConvert(reflect.TypeOf(int64(0)), reflect.TypeOf(uint8(0)), converter.Strict())
+		assert.Equal(t, converter.Maybe, verdict)
+		assert.Equal(t, "not all values of int64 can be represented exactly by uint8", reason)
+	})
+	t.Run("AssignOnly", func(t *testing.T) {
+		t.Parallel()
+
+		verdict, reason := converter.CanConvert(reflect.TypeOf(0), reflect.TypeOf(uint(0)), converter.AssignOnly())
+		assert.Equal(t, converter.No, verdict)
+		assert.Equal(t, "value of type int is not assignable to type uint", reason)
+
+		verdict, reason = converter.CanConvert(reflect.TypeOf((*any)(nil)).Elem(), reflect.TypeOf(0), converter.AssignOnly())
+		assert.Equal(t, converter.Maybe, verdict)
+		assert.Equal(t, "the dynamic type of interface {} is known at runtime only", reason)
+
+		verdict, _ = converter.CanConvert(reflect.TypeOf(0), reflect.TypeOf((*any)(nil)).Elem(), converter.AssignOnly())
+		assert.Equal(t, converter.Yes, verdict)
+	})
+	t.Run("WithConverter", func(t *testing.T) {
+		t.Parallel()
+
+		fn := func(reflect.Value, reflect.Type, converter.Convert) (reflect.Value, bool, error) {
+			return reflect.Value{}, false, nil
+		}
+
+		verdict, reason := converter.CanConvert(
+			reflect.TypeOf(struct{}{}),
+			reflect.TypeOf(0),
+			converter.WithConverter(fn, converter.AfterBuiltIn),
+		)
+		assert.Equal(t, converter.Maybe, verdict)
+		assert.Equal(t, "custom converters may convert struct {} to int at runtime", reason)
+	})
+	t.Run("RegisterType", func(t *testing.T) {
+		t.Parallel()
+
+		verdict, reason := converter.CanConvert(reflect.TypeOf([]string{}), reflect.TypeOf([]accountID{}))
+		assert.Equal(t, converter.Maybe, verdict)
+		assert.Equal(t, "element: custom converter of string to converter_test.accountID may return an error", reason)
+	})
+	t.Run("Register", func(t *testing.T) {
+		t.Parallel()
+
+		verdict, reason := converter.CanConvert(reflect.TypeOf(struct{}{}), reflect.TypeOf(0))
+		assert.Equal(t, converter.Maybe, verdict)
+		assert.Equal(t, "custom converters may convert struct {} to int at runtime", reason)
+	})
+}
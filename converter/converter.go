@@ -0,0 +1,174 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package converter
+
+import (
+	"fmt"
+	"reflect"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+)
+
+// Convert converts the given value to the given type using all available converters.
+type Convert = func(value any, to reflect.Type) (reflect.Value, error)
+
+/*
+Func converts the value `from` to the type `to`.
+It must return supports == false whenever it does not support the given pair of types,
+the next converter in the chain is used then.
+The third argument converts nested values, e.g. elements of slices.
+*/
+type Func = func(from reflect.Value, to reflect.Type, convert Convert) (_ reflect.Value, supports bool, _ error)
+
+// Precedence defines whether a custom converter is applied before or after the built-in ones.
+type Precedence uint8
+
+const (
+	// BeforeBuiltIn converters take precedence over the built-in ones.
+	BeforeBuiltIn Precedence = iota
+	// AfterBuiltIn converters are used whenever none of the built-in converters supports the given types.
+	AfterBuiltIn
+)
+
+/*
+Register registers a custom converter.
+Converters with the same [Precedence] are applied in the order of registration.
+Unlike [RegisterType] and [RegisterKind], converters registered this way
+cannot be inspected by [CanConvert] without a value.
+
+	converter.Register(
+		func(from reflect.Value, to reflect.Type, _ converter.Convert) (reflect.Value, bool, error) {
+			if from.Kind() != reflect.String || to != reflect.TypeOf((*url.URL)(nil)) {
+				return reflect.Value{}, false, nil
+			}
+
+			u, err := url.Parse(from.String())
+
+			return reflect.ValueOf(u), true, err
+		},
+		converter.BeforeBuiltIn, // pointers to structs are supported by the built-in converters
+	)
+*/
+func Register(fn Func, p Precedence) {
+	intReflect.RegisterConverter(fn, p == BeforeBuiltIn)
+}
+
+/*
+WithConverter applies the given converter to a single call of the function that accepts options,
+e.g. [copier.CopyWith], unlike [Register] that applies it globally.
+Converters given this way take precedence over the registered ones with the same [Precedence].
+
+	err := copier.CopyWith(from, &to, converter.WithConverter(parseURL, converter.BeforeBuiltIn))
+
+[copier.CopyWith]: https://pkg.go.dev/github.com/gontainer/reflectpro/copier#CopyWith
+*/
+func WithConverter(fn Func, p Precedence) Option {
+	return func(o *intReflect.Options) {
+		if p == BeforeBuiltIn {
+			o.BeforeBuiltIn = append(o.BeforeBuiltIn[:len(o.BeforeBuiltIn):len(o.BeforeBuiltIn)], fn)
+
+			return
+		}
+
+		o.AfterBuiltIn = append(o.AfterBuiltIn[:len(o.AfterBuiltIn):len(o.AfterBuiltIn)], fn)
+	}
+}
+
+/*
+RegisterType registers a converter for the given pair of types.
+The value returned by `fn` must be assignable to the type `to`.
+
+	converter.RegisterType(
+		reflect.TypeOf(""),
+		reflect.TypeOf(time.Duration(0)),
+		func(from any) (any, error) {
+			return time.ParseDuration(from.(string))
+		},
+		converter.BeforeBuiltIn,
+	)
+*/
+func RegisterType(from, to reflect.Type, fn func(from any) (any, error), p Precedence) {
+	intReflect.RegisterTypedConverter(
+		func(v reflect.Value, t reflect.Type, _ Convert) (reflect.Value, bool, error) {
+			if v.Type() != from || t != to {
+				return reflect.Value{}, false, nil
+			}
+
+			r, err := fn(v.Interface())
+			if err != nil {
+				return reflect.Value{}, true, err
+			}
+
+			result, err := intReflect.ValueOf(r, to, false)
+
+			return result, true, err //nolint:wrapcheck
+		},
+		func(f, t reflect.Type) bool {
+			return f == from && t == to
+		},
+		p == BeforeBuiltIn,
+	)
+}
+
+/*
+RegisterKind registers a converter for the given pair of kinds.
+The value returned by `fn` must be assignable to the type `to`.
+
+	// non-empty slices are converted to true
+	converter.RegisterKind(
+		reflect.Slice,
+		reflect.Bool,
+		func(from reflect.Value, to reflect.Type) (reflect.Value, error) {
+			return reflect.ValueOf(from.Len() > 0).Convert(to), nil
+		},
+		converter.AfterBuiltIn,
+	)
+*/
+func RegisterKind(
+	from, to reflect.Kind,
+	fn func(from reflect.Value, to reflect.Type) (reflect.Value, error),
+	p Precedence,
+) {
+	intReflect.RegisterTypedConverter(
+		func(v reflect.Value, t reflect.Type, _ Convert) (reflect.Value, bool, error) {
+			if v.Kind() != from || t.Kind() != to {
+				return reflect.Value{}, false, nil
+			}
+
+			r, err := fn(v, t)
+			if err != nil {
+				return reflect.Value{}, true, err
+			}
+
+			if !r.IsValid() {
+				return reflect.Value{}, true, fmt.Errorf("converter returned invalid value for %s", t.String())
+			}
+
+			result, err := intReflect.ValueOf(r.Interface(), t, false)
+
+			return result, true, err //nolint:wrapcheck
+		},
+		func(f, t reflect.Type) bool {
+			return f.Kind() == from && t.Kind() == to
+		},
+		p == BeforeBuiltIn,
+	)
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package converter_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gontainer/reflectpro/caller"
+	"github.com/gontainer/reflectpro/converter"
+	"github.com/gontainer/reflectpro/copier"
+	"github.com/gontainer/reflectpro/setter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	accountID string
+	celsius   float64
+	flag      bool
+	present   bool
+)
+
+//nolint:gochecknoinits
+func init() {
+	converter.RegisterType(
+		reflect.TypeOf(""),
+		reflect.TypeOf(accountID("")),
+		func(from any) (any, error) {
+			s, _ := from.(string)
+			if !strings.HasPrefix(s, "acc-") {
+				return nil, errors.New("account ID must start with \"acc-\"")
+			}
+
+			return accountID(strings.TrimPrefix(s, "acc-")), nil
+		},
+		converter.BeforeBuiltIn,
+	)
+
+	// the built-in converter would copy the value
+	converter.RegisterType(
+		reflect.TypeOf(float64(0)),
+		reflect.TypeOf(celsius(0)),
+		func(from any) (any, error) {
+			f, _ := from.(float64)
+
+			return celsius((f - 32) * 5 / 9), nil //nolint:gomnd
+		},
+		converter.BeforeBuiltIn,
+	)
+
+	// the built-in converter would copy the value, but it is registered after
+	converter.RegisterType(
+		reflect.TypeOf(float32(0)),
+		reflect.TypeOf(celsius(0)),
+		func(any) (any, error) {
+			return celsius(-1), nil
+		},
+		converter.AfterBuiltIn,
+	)
+
+	converter.RegisterKind(
+		reflect.Slice,
+		reflect.Bool,
+		func(from reflect.Value, to reflect.Type) (reflect.Value, error) {
+			if to == reflect.TypeOf(present(false)) {
+				return reflect.Value{}, nil
+			}
+
+			return reflect.ValueOf(from.Len() > 0).Convert(to), nil
+		},
+		converter.AfterBuiltIn,
+	)
+
+	converter.Register(
+		func(from reflect.Value, to reflect.Type, convert converter.Convert) (reflect.Value, bool, error) {
+			// the built-in converter rejects "y" and "n" with an error, so this one must precede it
+			if to != reflect.TypeOf(flag(false)) || from.Kind() != reflect.String {
+				return reflect.Value{}, false, nil
+			}
+
+			if s := from.String(); s != "y" && s != "n" {
+				return reflect.Value{}, false, nil
+			}
+
+			b, err := convert(from.String() == "y", reflect.TypeOf(false))
+			if err != nil {
+				return reflect.Value{}, true, err
+			}
+
+			return b.Convert(to), true, nil
+		},
+		converter.BeforeBuiltIn,
+	)
+}
+
+func TestRegisterType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("copier.Copy", func(t *testing.T) {
+		t.Parallel()
+
+		var id accountID
+		require.NoError(t, copier.Copy("acc-1234", &id, true))
+		assert.Equal(t, accountID("1234"), id)
+	})
+	t.Run("copier.Copy without conversion", func(t *testing.T) {
+		t.Parallel()
+
+		var id accountID
+		err := copier.Copy("acc-1234", &id, false)
+		assert.EqualError(t, err, "value of type string is not assignable to type converter_test.accountID")
+	})
+	t.Run("setter.Set", func(t *testing.T) {
+		t.Parallel()
+
+		var account struct {
+			id accountID
+		}
+		require.NoError(t, setter.Set(&account, "id", "acc-5678", true))
+		assert.Equal(t, accountID("5678"), account.id)
+	})
+	t.Run("caller.Call", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := caller.Call(
+			func(ids ...accountID) []accountID {
+				return ids
+			},
+			[]any{"acc-1", "acc-2"},
+			true,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []accountID{"1", "2"}, r[0])
+	})
+	t.Run("Nested values", func(t *testing.T) {
+		t.Parallel()
+
+		var ids map[string][]accountID
+		require.NoError(t, copier.Copy(map[string]any{"admins": []string{"acc-1"}}, &ids, true))
+		assert.Equal(t, map[string][]accountID{"admins": {"1"}}, ids)
+	})
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		var ids []accountID
+		err := copier.Copy([]any{"acc-1", "2"}, &ids, true)
+		assert.EqualError(
+			t,
+			err,
+			`cannot convert []interface {} to []converter_test.accountID: #1: `+
+				`cannot convert string to converter_test.accountID: account ID must start with "acc-"`,
+		)
+	})
+	t.Run("Precedence", func(t *testing.T) {
+		t.Parallel()
+
+		var c celsius
+		require.NoError(t, copier.Copy(float64(212), &c, true))
+		assert.Equal(t, celsius(100), c)
+
+		require.NoError(t, copier.Copy(float32(212), &c, true))
+		assert.Equal(t, celsius(212), c)
+	})
+}
+
+func TestRegisterType_afterConversion(t *testing.T) {
+	t.Parallel()
+
+	type percent float32
+
+	var p percent
+	require.NoError(t, copier.Copy("12.5", &p, true))
+	assert.Equal(t, percent(12.5), p)
+
+	// the converter registered later takes precedence over the previously compiled one
+	converter.RegisterType(
+		reflect.TypeOf(""),
+		reflect.TypeOf(percent(0)),
+		func(from any) (any, error) {
+			s, _ := from.(string)
+			f, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 32)
+
+			return percent(f), err
+		},
+		converter.BeforeBuiltIn,
+	)
+
+	require.NoError(t, copier.Copy("50%", &p, true))
+	assert.Equal(t, percent(50), p)
+}
+
+func TestRegisterKind(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		var b []bool
+		require.NoError(t, copier.Copy([]any{[]int{1}, []string{}}, &b, true))
+		assert.Equal(t, []bool{true, false}, b)
+	})
+	t.Run("Invalid value", func(t *testing.T) {
+		t.Parallel()
+
+		var p present
+		err := copier.Copy([]int{1}, &p, true)
+		assert.EqualError(
+			t,
+			err,
+			"cannot convert []int to converter_test.present: converter returned invalid value for converter_test.present",
+		)
+	})
+}
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	var f flag
+	require.NoError(t, copier.Copy("y", &f, true))
+	assert.Equal(t, flag(true), f)
+
+	require.NoError(t, copier.Copy("n", &f, true))
+	assert.Equal(t, flag(false), f)
+
+	// other values are converted by the built-in converter
+	require.NoError(t, copier.Copy("yes", &f, true))
+	assert.Equal(t, flag(true), f)
+
+	var b bool
+	assert.EqualError(t, copier.Copy("y", &b, true), `cannot convert string to bool: parsing "y": invalid syntax`)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package converter allows for registering custom converters
+used by [copier], [setter] and [caller] whenever the conversion is enabled.
+
+	type UserID string
+
+	converter.RegisterType(
+		reflect.TypeOf(""),
+		reflect.TypeOf(UserID("")),
+		func(from any) (any, error) {
+			return UserID(strings.ToLower(from.(string))), nil
+		},
+		converter.BeforeBuiltIn,
+	)
+
+	var id UserID
+	_ = copier.Copy("JANE", &id, true)
+	fmt.Println(id) // jane
+
+[copier]: https://pkg.go.dev/github.com/gontainer/reflectpro/copier
+[setter]: https://pkg.go.dev/github.com/gontainer/reflectpro/setter
+[caller]: https://pkg.go.dev/github.com/gontainer/reflectpro/caller
+*/
+package converter
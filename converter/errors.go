@@ -0,0 +1,48 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package converter
+
+import (
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+)
+
+type (
+	// ConversionError is returned whenever a value cannot be converted.
+	// Use [errors.As] to inspect the source type, the target type, and the path to the offending value.
+	ConversionError = intReflect.ConversionError
+	// Path is the path to the offending value, e.g. `.Servers[2].Ports["http"]`.
+	Path = intReflect.Path
+	// PathSegment is a single step of [Path].
+	PathSegment = intReflect.PathSegment
+	// PathSegmentKind defines the kind of [PathSegment].
+	PathSegmentKind = intReflect.PathSegmentKind
+)
+
+const (
+	// SliceIndex is an element of a slice or an array.
+	SliceIndex = intReflect.SliceIndex
+	// MapKey is a key of a map.
+	MapKey = intReflect.MapKey
+	// MapValue is a value of a map.
+	MapValue = intReflect.MapValue
+	// StructField is a field of a struct, or a key of a map decoded into a struct, or encoded from a struct.
+	StructField = intReflect.StructField
+)
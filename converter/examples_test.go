@@ -0,0 +1,365 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package converter_test
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gontainer/reflectpro/converter"
+	"github.com/gontainer/reflectpro/copier"
+)
+
+type UserID string
+
+func ExampleRegisterType() {
+	converter.RegisterType(
+		reflect.TypeOf(""),
+		reflect.TypeOf(UserID("")),
+		func(from any) (any, error) {
+			s, _ := from.(string)
+
+			return UserID(strings.ToLower(s)), nil
+		},
+		converter.BeforeBuiltIn,
+	)
+
+	var (
+		from = []any{"JANE", "John"}
+		to   []UserID
+	)
+
+	err := copier.Copy(from, &to, true)
+
+	fmt.Println(to)
+	fmt.Println(err)
+
+	// Output:
+	// [jane john]
+	// <nil>
+}
+
+//nolint:lll
+func ExampleStrict() {
+	converter.SetDefaults(converter.Strict())
+	defer converter.SetDefaults()
+
+	var (
+		i8 int8
+		u  uint
+		i  int
+	)
+
+	fmt.Println(copier.Copy(int64(300), &i8, true))
+	fmt.Println(copier.Copy(-1, &u, true))
+	fmt.Println(copier.Copy(3.9, &i, true))
+	fmt.Println(copier.Copy([]float64{1, 2.5}, new([]int), true))
+
+	// Output:
+	// cannot convert int64 to int8: value 300 overflows int8
+	// cannot convert int to uint: negative value -1 cannot be converted to uint
+	// cannot convert float64 to int: value 3.9 cannot be converted to int without truncation
+	// cannot convert []float64 to []int: #1: cannot convert float64 to int: value 2.5 cannot be converted to int without truncation
+}
+
+func ExampleRuneConversion() {
+	var s string
+
+	_ = copier.Copy(65, &s, true)
+	fmt.Println(s)
+
+	converter.SetDefaults(converter.RuneConversion())
+	defer converter.SetDefaults()
+
+	_ = copier.Copy(65, &s, true)
+	fmt.Println(s)
+
+	// Output:
+	// 65
+	// A
+}
+
+//nolint:lll
+func ExampleTagName() {
+	type (
+		Pool struct {
+			Max uint `json:"max"`
+		}
+		Database struct {
+			Host string `json:"host"`
+			Pool Pool   `json:"pool"`
+		}
+		Config struct {
+			Database *Database `json:"database"`
+		}
+	)
+
+	converter.SetDefaults(converter.TagName("json"))
+	defer converter.SetDefaults()
+
+	from := map[string]any{
+		"database": map[string]any{
+			"host": "localhost",
+			"pool": map[string]any{
+				"max": "10",
+			},
+		},
+	}
+
+	var cfg Config
+
+	fmt.Println(copier.Copy(from, &cfg, true))
+	fmt.Println(cfg.Database.Host, cfg.Database.Pool.Max)
+
+	from["database"].(map[string]any)["pool"].(map[string]any)["max"] = "ten" //nolint:forcetypeassert
+	fmt.Println(copier.Copy(from, &cfg, true))
+
+	// Output:
+	// <nil>
+	// localhost 10
+	// cannot convert map[string]interface {} to converter_test.Config: database.pool.max: cannot convert string to uint: parsing "ten": invalid syntax
+}
+
+func ExampleEncodeUnexportedFields() {
+	type (
+		Database struct {
+			Host     string
+			password string
+		}
+		Config struct {
+			Name     string
+			Database Database
+			Tags     []string
+		}
+	)
+
+	cfg := Config{
+		Name:     "app",
+		Database: Database{Host: "localhost", password: "secret"},
+		Tags:     []string{"a", "b"},
+	}
+
+	var flat map[string]string
+
+	_ = copier.Copy(cfg, &flat, true)
+	fmt.Println(flat)
+
+	converter.SetDefaults(converter.EncodeUnexportedFields())
+	defer converter.SetDefaults()
+
+	var tree map[string]any
+
+	_ = copier.Copy(cfg, &tree, true)
+	fmt.Println(tree)
+
+	// Output:
+	// map[Database.Host:localhost Name:app Tags.0:a Tags.1:b]
+	// map[Database:map[Host:localhost password:secret] Name:app Tags:[a b]]
+}
+
+func ExampleDurationUnit() {
+	converter.SetDefaults(
+		converter.DurationUnit(time.Second),
+		converter.TimeLayouts("2006-01-02", time.RFC3339),
+	)
+	defer converter.SetDefaults()
+
+	type Config struct {
+		Timeout  time.Duration
+		Interval time.Duration
+		Since    time.Time
+	}
+
+	var cfg Config
+
+	err := copier.Copy(
+		map[string]any{
+			"Timeout":  "1m30s",
+			"Interval": 5,
+			"Since":    "2023-01-02",
+		},
+		&cfg,
+		true,
+	)
+
+	fmt.Println(err)
+	fmt.Println(cfg.Timeout, cfg.Interval, cfg.Since.Format(time.RFC3339))
+
+	// Output:
+	// <nil>
+	// 1m30s 5s 2023-01-02T00:00:00Z
+}
+
+func ExampleCanConvert() {
+	type Server struct {
+		Host string
+		Port int
+	}
+
+	check := func(from, to any) {
+		verdict, reason := converter.CanConvert(reflect.TypeOf(from), reflect.TypeOf(to))
+		fmt.Printf("%s %q\n", verdict, reason)
+	}
+
+	check(Server{}, map[string]any{})
+	check([]any{}, []Server{})
+	check(map[string]string{}, Server{})
+
+	// Output:
+	// yes ""
+	// maybe "element: the dynamic type of interface {} is known at runtime only"
+	// maybe "field \"Port\": parsing int depends on the content of the string"
+}
+
+func ExampleCanConvert_defaults() {
+	converter.SetDefaults(converter.DurationUnit(time.Second))
+	defer converter.SetDefaults()
+
+	// the given options are applied on top of the defaults
+	verdict, reason := converter.CanConvert(reflect.TypeOf(int64(0)), reflect.TypeOf(time.Duration(0)), converter.Strict())
+	fmt.Printf("%s %q\n", verdict, reason)
+
+	// Output:
+	// maybe "values of int64 multiplied by 1s may overflow time.Duration"
+}
+
+func ExampleOnArrayLength() {
+	var key [4]byte
+
+	fmt.Println(copier.Copy([]byte{1, 2, 3, 4, 5}, &key, true), key)
+
+	converter.SetDefaults(converter.OnArrayLength(converter.Exact))
+	defer converter.SetDefaults()
+
+	fmt.Println(copier.Copy([]byte{1, 2, 3, 4, 5}, &key, true))
+	fmt.Println(copier.Copy([]byte{1, 2}, &key, true))
+
+	// Output:
+	// <nil> [1 2 3 4]
+	// cannot convert []uint8 to [4]uint8: source length 5 exceeds target length 4
+	// cannot convert []uint8 to [4]uint8: source length 2 is less than target length 4
+}
+
+func ExampleOnKeyCollisions() {
+	from := map[any]string{
+		int8(1):  "a",
+		int64(1): "b",
+	}
+
+	var to map[int]string
+
+	fmt.Println(copier.Copy(from, &to, true))
+
+	converter.SetDefaults(converter.OnKeyCollisions(converter.FirstKeyWins))
+	defer converter.SetDefaults()
+
+	fmt.Println(copier.Copy(from, &to, true), to)
+
+	// Output:
+	// cannot convert map[interface {}]string to map[int]string: map key: keys int64(1), int8(1) collide as int(1)
+	// <nil> map[1:b]
+}
+
+//nolint:lll
+func ExampleOnCycles() {
+	type Graph map[string]Graph
+
+	doc := map[string]any{}
+	doc["self"] = doc
+
+	var g Graph
+
+	fmt.Println(copier.Copy(doc, &g, true))
+	fmt.Println(reflect.ValueOf(g).Pointer() == reflect.ValueOf(g["self"]).Pointer())
+
+	converter.SetDefaults(converter.OnCycles(converter.RejectCycles))
+	defer converter.SetDefaults()
+
+	fmt.Println(copier.Copy(doc, &g, true))
+
+	// Output:
+	// <nil>
+	// true
+	// cannot convert map[string]interface {} to converter_test.Graph: map value: cannot convert map[string]interface {} to converter_test.Graph: cycle detected: map[string]interface {} refers to itself
+}
+
+func ExampleConversionError() {
+	type (
+		Server struct {
+			Ports map[string]int
+		}
+		Config struct {
+			Servers []Server
+		}
+	)
+
+	from := map[string]any{
+		"Servers": []any{
+			map[string]any{"Ports": map[string]any{"http": 80}},
+			map[string]any{"Ports": map[string]any{"http": "eighty"}},
+		},
+	}
+
+	var cfg Config
+
+	err := copier.Copy(from, &cfg, true)
+
+	var convErr *converter.ConversionError
+	if errors.As(err, &convErr) {
+		fmt.Println(convErr.Path())
+		fmt.Println(convErr.Innermost())
+	}
+
+	// Output:
+	// .Servers[1].Ports["http"]
+	// cannot convert string to int: parsing "eighty": invalid syntax
+}
+
+func ExampleWithConverter() {
+	parseURL := func(from reflect.Value, to reflect.Type, _ converter.Convert) (reflect.Value, bool, error) {
+		if from.Kind() != reflect.String || to != reflect.TypeOf((*url.URL)(nil)) {
+			return reflect.Value{}, false, nil
+		}
+
+		u, err := url.Parse(from.String())
+
+		return reflect.ValueOf(u), true, err
+	}
+
+	var to []*url.URL
+
+	err := copier.CopyWith(
+		[]string{"https://example.com", "https://example.org"},
+		&to,
+		converter.WithConverter(parseURL, converter.BeforeBuiltIn),
+	)
+
+	fmt.Println(to[1].Host)
+	fmt.Println(err)
+
+	// Output:
+	// example.org
+	// <nil>
+}
@@ -0,0 +1,370 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package converter
+
+import (
+	"time"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+)
+
+// Option configures conversions, see [SetDefaults].
+type Option = intReflect.Option
+
+/*
+Strict enables the strict conversion of numbers.
+It rejects integer overflows, sign losses, truncations of floats,
+conversions of NaN and infinities to integers,
+and integers that cannot be represented exactly by floats.
+It applies to elements of slices, arrays and maps as well.
+
+	converter.SetDefaults(converter.Strict())
+
+	var to uint8
+	err := copier.Copy(int64(300), &to, true)
+	fmt.Println(err) // cannot convert int64 to uint8: value 300 overflows uint8
+*/
+func Strict() Option {
+	return func(o *intReflect.Options) {
+		o.Strict = true
+	}
+}
+
+/*
+RuneConversion converts integers to strings as runes, as the Go conversion does.
+By default, integers are formatted in base 10.
+
+	converter.SetDefaults(converter.RuneConversion())
+
+	var to string
+	_ = copier.Copy(65, &to, true)
+	fmt.Println(to) // A
+*/
+func RuneConversion() Option {
+	return func(o *intReflect.Options) {
+		o.RuneConversion = true
+	}
+}
+
+/*
+AssignOnly disables conversions, values must be assignable to the target type.
+It lets the functions that accept options, e.g. [copier.CopyWith],
+work as their counterparts called with convert == false.
+
+	var to uint
+	err := copier.CopyWith(5, &to, converter.AssignOnly())
+	fmt.Println(err) // value of type int is not assignable to type uint
+
+[copier.CopyWith]: https://pkg.go.dev/github.com/gontainer/reflectpro/copier#CopyWith
+*/
+func AssignOnly() Option {
+	return func(o *intReflect.Options) {
+		o.AssignOnly = true
+	}
+}
+
+/*
+NilAsZero converts the untyped nil to the zero value of any type.
+By default, only types of nil-able kinds, e.g. pointers, maps and slices, accept nil.
+
+	var to int
+	_ = copier.CopyWith(nil, &to, converter.NilAsZero())
+	fmt.Println(to) // 0
+*/
+func NilAsZero() Option {
+	return func(o *intReflect.Options) {
+		o.NilAsZero = true
+	}
+}
+
+/*
+SetDefaults sets the options applied to all conversions
+made by [copier], [setter] and [caller].
+Options that are not given are reset to their default values.
+
+	converter.SetDefaults(converter.Strict())
+	defer converter.SetDefaults() // restore defaults
+
+[copier]: https://pkg.go.dev/github.com/gontainer/reflectpro/copier
+[setter]: https://pkg.go.dev/github.com/gontainer/reflectpro/setter
+[caller]: https://pkg.go.dev/github.com/gontainer/reflectpro/caller
+*/
+func SetDefaults(opts ...Option) {
+	intReflect.SetDefaultOptions(intReflect.ApplyOptions(intReflect.Options{}, opts...))
+}
+
+// UnmatchedFields defines how to handle fields without counterparts while converting structs.
+type UnmatchedFields uint8
+
+const (
+	// IgnoreUnmatchedFields leaves target fields without source unchanged, and skips source fields without target.
+	// Structs without any matching fields are rejected, unless [AllowNoMatchingFields] is given.
+	IgnoreUnmatchedFields = UnmatchedFields(intReflect.IgnoreUnmatchedFields)
+	// RejectMissingFields rejects target fields without source.
+	RejectMissingFields = UnmatchedFields(intReflect.RejectMissingFields)
+	// RejectUnknownFields rejects source fields without target.
+	RejectUnknownFields = UnmatchedFields(intReflect.RejectUnknownFields)
+	// RejectUnmatchedFields rejects fields without counterparts in both structs.
+	RejectUnmatchedFields = UnmatchedFields(intReflect.RejectUnmatchedFields)
+	// AllowNoMatchingFields converts structs without any matching fields to zero values,
+	// e.g. converter.OnUnmatchedFields(converter.IgnoreUnmatchedFields | converter.AllowNoMatchingFields).
+	AllowNoMatchingFields = UnmatchedFields(intReflect.AllowNoMatchingFields)
+)
+
+/*
+OnUnmatchedFields defines how to handle fields without counterparts while converting structs.
+Fields are matched by names, the tag `reflectpro` overrides the name of the field:
+
+	type PersonDTO struct {
+		FullName string `reflectpro:"Name"`
+		Age      string
+	}
+
+	type Person struct {
+		Name string
+		Age  int
+		ID   int
+	}
+
+	converter.SetDefaults(converter.OnUnmatchedFields(converter.RejectMissingFields))
+
+	var p Person
+	err := copier.Copy(PersonDTO{FullName: "Jane", Age: "30"}, &p, true)
+	fmt.Println(err) // cannot convert PersonDTO to Person: fields without source in Person: "ID"
+*/
+func OnUnmatchedFields(p UnmatchedFields) Option {
+	return func(o *intReflect.Options) {
+		o.UnmatchedFields = intReflect.UnmatchedFields(p)
+	}
+}
+
+/*
+TagName sets the name of the struct tag that overrides names of fields
+while converting structs and decoding maps into structs. The default one is `reflectpro`.
+
+	type Config struct {
+		Host string `json:"host"`
+	}
+
+	converter.SetDefaults(converter.TagName("json"))
+
+	var cfg Config
+	_ = copier.Copy(map[string]any{"host": "localhost"}, &cfg, true)
+	fmt.Println(cfg.Host) // localhost
+*/
+func TagName(name string) Option {
+	return func(o *intReflect.Options) {
+		o.TagName = name
+	}
+}
+
+/*
+CaseInsensitiveKeys matches keys of maps to fields of structs regardless of the case,
+e.g. the key "host" matches the field "Host". Exact matches take precedence.
+*/
+func CaseInsensitiveKeys() Option {
+	return func(o *intReflect.Options) {
+		o.CaseInsensitiveKeys = true
+	}
+}
+
+/*
+EncodeUnexportedFields includes unexported fields while encoding structs into maps.
+By default, only exported fields are encoded.
+*/
+func EncodeUnexportedFields() Option {
+	return func(o *intReflect.Options) {
+		o.EncodeUnexportedFields = true
+	}
+}
+
+/*
+StringerFallback converts types implementing [fmt.Stringer] to strings.
+Types implementing [encoding.TextMarshaler] are always converted using MarshalText, it takes precedence.
+
+	var s string
+	_ = copier.Copy(time.Second, &s, true)
+	fmt.Println(s) // 1000000000
+
+	converter.SetDefaults(converter.StringerFallback())
+	_ = copier.Copy(time.Second, &s, true)
+	fmt.Println(s) // 1s
+*/
+func StringerFallback() Option {
+	return func(o *intReflect.Options) {
+		o.StringerFallback = true
+	}
+}
+
+/*
+DurationUnit sets the unit of integers converted to [time.Duration].
+By default, integers are converted as nanoseconds, the same as in Go.
+Integral floats, e.g. numbers decoded from JSON, are converted the same way, other floats are rejected.
+Strings are always parsed using [time.ParseDuration].
+
+	converter.SetDefaults(converter.DurationUnit(time.Second))
+
+	var d time.Duration
+	_ = copier.Copy(90, &d, true)
+	fmt.Println(d) // 1m30s
+*/
+func DurationUnit(unit time.Duration) Option {
+	return func(o *intReflect.Options) {
+		o.DurationUnit = unit
+	}
+}
+
+/*
+TimeLayouts sets the layouts used to parse strings converted to [time.Time].
+Layouts are tried in the given order. The default one is [time.RFC3339].
+Integers are always converted as Unix timestamps in UTC.
+
+	converter.SetDefaults(converter.TimeLayouts("2006-01-02", time.RFC3339))
+*/
+func TimeLayouts(layouts ...string) Option {
+	return func(o *intReflect.Options) {
+		o.TimeLayouts = append([]string(nil), layouts...)
+	}
+}
+
+// ArrayLength defines how to handle slices and arrays of lengths different from the length of the target array.
+type ArrayLength uint8
+
+const (
+	// TruncateOrPad truncates longer sources, and pads shorter ones with zero values.
+	TruncateOrPad = ArrayLength(intReflect.TruncateOrPad)
+	// Truncate truncates longer sources, and rejects shorter ones.
+	Truncate = ArrayLength(intReflect.Truncate)
+	// Pad pads shorter sources with zero values, and rejects longer ones.
+	Pad = ArrayLength(intReflect.Pad)
+	// Exact rejects sources of lengths different from the length of the target array.
+	Exact = ArrayLength(intReflect.Exact)
+)
+
+/*
+OnArrayLength defines how to handle slices and arrays of lengths different from the length of the target array.
+By default, longer sources are truncated, and shorter ones are padded with zero values.
+
+	converter.SetDefaults(converter.OnArrayLength(converter.Exact))
+
+	var hash [32]byte
+	err := copier.Copy([]byte{1, 2, 3}, &hash, true)
+	fmt.Println(err) // cannot convert []uint8 to [32]uint8: source length 3 is less than target length 32
+*/
+func OnArrayLength(p ArrayLength) Option {
+	return func(o *intReflect.Options) {
+		o.ArrayLength = intReflect.ArrayLength(p)
+	}
+}
+
+// KeyCollisions defines how to handle keys of maps converted to the same key.
+type KeyCollisions uint8
+
+const (
+	// RejectKeyCollisions returns an error that lists the colliding keys.
+	RejectKeyCollisions = KeyCollisions(intReflect.RejectKeyCollisions)
+	// FirstKeyWins keeps the value of the first colliding key.
+	FirstKeyWins = KeyCollisions(intReflect.FirstKeyWins)
+	// LastKeyWins keeps the value of the last colliding key.
+	LastKeyWins = KeyCollisions(intReflect.LastKeyWins)
+)
+
+/*
+OnKeyCollisions defines how to handle keys of maps converted to the same key,
+e.g. int8(1) and int64(1) converted to int, or "host" and "HOST" matching the same field,
+see [CaseInsensitiveKeys]. By default, collisions are rejected.
+
+To make the result independent of the order of iteration, colliding keys are ordered:
+numbers by value, strings lexicographically, other keys by the names of their types,
+and then by their string representation.
+
+	converter.SetDefaults(converter.OnKeyCollisions(converter.LastKeyWins))
+*/
+func OnKeyCollisions(p KeyCollisions) Option {
+	return func(o *intReflect.Options) {
+		o.KeyCollisions = intReflect.KeyCollisions(p)
+	}
+}
+
+// Cycles defines how to handle maps, slices and pointers that refer to themselves.
+type Cycles uint8
+
+const (
+	// PreserveCycles makes the result refer to itself the same way.
+	// Cycles that go through values that cannot refer to themselves, e.g. arrays, are rejected.
+	PreserveCycles = Cycles(intReflect.PreserveCycles)
+	// RejectCycles returns an error.
+	RejectCycles = Cycles(intReflect.RejectCycles)
+)
+
+/*
+OnCycles defines how to handle maps, slices and pointers that refer to themselves.
+By default, cycles are preserved.
+
+	type Graph map[string]Graph
+
+	doc := map[string]any{}
+	doc["self"] = doc
+
+	converter.SetDefaults(converter.OnCycles(converter.RejectCycles))
+
+	var g Graph
+	err := copier.Copy(doc, &g, true)
+	fmt.Println(err) // cannot convert map[string]interface {} to Graph: map value: ...: cycle detected: ...
+*/
+func OnCycles(p Cycles) Option {
+	return func(o *intReflect.Options) {
+		o.Cycles = intReflect.Cycles(p)
+	}
+}
+
+// FieldMatching defines how names given to getters and setters match names of fields.
+type FieldMatching uint8
+
+const (
+	// MatchExact matches names that are equal.
+	MatchExact = FieldMatching(intReflect.MatchExact)
+	// MatchCaseInsensitive matches names regardless of the case, e.g. "maxconns" matches "MaxConns".
+	MatchCaseInsensitive = FieldMatching(intReflect.MatchCaseInsensitive)
+	// MatchNormalized matches names regardless of the case, underscores and hyphens,
+	// e.g. "MAX_CONNS" and "max-conns" match "MaxConns".
+	MatchNormalized = FieldMatching(intReflect.MatchNormalized)
+)
+
+/*
+MatchFieldNames defines how names given to getters and setters, e.g. [getter.GetWith] and [setter.SetWith],
+match names of fields. Exact matches take precedence. By default, names must be equal.
+Whenever more than one field matches the given name, an error is returned.
+
+	var cfg struct {
+		MaxConns int
+	}
+	_ = setter.SetWith(&cfg, "MAX_CONNS", "10", converter.MatchFieldNames(converter.MatchNormalized))
+	fmt.Println(cfg.MaxConns) // 10
+
+[getter.GetWith]: https://pkg.go.dev/github.com/gontainer/reflectpro/getter#GetWith
+[setter.SetWith]: https://pkg.go.dev/github.com/gontainer/reflectpro/setter#SetWith
+*/
+func MatchFieldNames(m FieldMatching) Option {
+	return func(o *intReflect.Options) {
+		o.FieldMatching = intReflect.FieldMatching(m)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/gontainer/reflectpro/converter"
 	intReflect "github.com/gontainer/reflectpro/internal/reflect"
 )
 
@@ -36,13 +37,30 @@ Copy copies a value of `from` to `to`. The third parameter says whether the valu
 	fmt.Println(to) // 5
 */
 func Copy(from any, to any, convert bool) error {
+	return copyValue(from, to, intReflect.ConvertOptions(convert))
+}
+
+/*
+CopyWith works similar to [Copy], but it accepts options of the conversion.
+The given options are applied on top of the defaults, see [converter.SetDefaults].
+It converts the value whenever possible, unless [converter.AssignOnly] is given.
+
+	var to uint8
+	err := copier.CopyWith(300, &to, converter.Strict())
+	fmt.Println(err) // cannot convert int to uint8: value 300 overflows uint8
+*/
+func CopyWith(from any, to any, opts ...converter.Option) error {
+	return copyValue(from, to, intReflect.NewOptions(opts...))
+}
+
+func copyValue(from any, to any, opts intReflect.Options) error {
 	t := reflect.ValueOf(to)
 
 	if t.Kind() != reflect.Ptr {
 		return fmt.Errorf("expected %s, %T given", reflect.Ptr.String(), to)
 	}
 
-	f, err := intReflect.ValueOf(from, t.Elem().Type(), convert)
+	f, err := intReflect.ValueOfWith(from, t.Elem().Type(), opts)
 	if err != nil {
 		return err //nolint:wrapcheck
 	}
@@ -22,9 +22,11 @@ package copier_test
 
 import (
 	"fmt"
+	"net"
 	"reflect"
 	"testing"
 
+	"github.com/gontainer/reflectpro/converter"
 	"github.com/gontainer/reflectpro/copier"
 	"github.com/stretchr/testify/assert"
 )
@@ -81,9 +83,22 @@ func TestCopy(t *testing.T) {
 			)
 			err := copier.Copy(from, &to, true)
 			assert.Empty(t, to)
-			assert.EqualError(t, err, "cannot convert *int to *uint")
+			assert.NoError(t, err)
+
+			i := 5
+			err = copier.Copy(&i, &to, true)
+			assert.NoError(t, err)
+			assert.Equal(t, uint(5), *to)
 		})
 	})
+	t.Run("Raw bytes", func(t *testing.T) {
+		t.Parallel()
+
+		var to net.IP
+		err := copier.Copy([]byte{10, 0, 0, 1}, &to, true)
+		assert.NoError(t, err)
+		assert.Equal(t, net.IP{10, 0, 0, 1}, to)
+	})
 	t.Run("Non-empty interface", func(t *testing.T) {
 		t.Parallel()
 
@@ -161,6 +176,19 @@ func TestCopy(t *testing.T) {
 				msg,
 			)
 		})
+		t.Run("struct referring to itself", func(t *testing.T) {
+			t.Parallel()
+
+			type node struct {
+				Next *node
+			}
+
+			n := &node{}
+			n.Next = n
+
+			assert.ErrorContains(t, copier.Copy(n, &map[string]any{}, true), "cycle detected")
+			assert.ErrorContains(t, copier.Copy(n, &map[string]string{}, true), "cycle detected")
+		})
 	})
 	t.Run("Copy to nil", func(t *testing.T) {
 		t.Parallel()
@@ -285,6 +313,67 @@ func TestCopy(t *testing.T) {
 	})
 }
 
+func TestCopyWith(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Convert by default", func(t *testing.T) {
+		t.Parallel()
+
+		var to uint
+
+		err := copier.CopyWith(5, &to)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), to)
+	})
+	t.Run("AssignOnly", func(t *testing.T) {
+		t.Parallel()
+
+		var to uint
+
+		err := copier.CopyWith(5, &to, converter.AssignOnly())
+		assert.EqualError(t, err, "value of type int is not assignable to type uint")
+	})
+	t.Run("Strict", func(t *testing.T) {
+		t.Parallel()
+
+		var to []uint8
+
+		err := copier.CopyWith([]int{1, 300}, &to, converter.Strict())
+		assert.EqualError(
+			t,
+			err,
+			"cannot convert []int to []uint8: #1: cannot convert int to uint8: value 300 overflows uint8",
+		)
+	})
+	t.Run("NilAsZero", func(t *testing.T) {
+		t.Parallel()
+
+		to := 5
+
+		err := copier.CopyWith(nil, &to, converter.NilAsZero())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, to)
+	})
+	t.Run("Pointer to array", func(t *testing.T) {
+		t.Parallel()
+
+		var to *[2]int
+
+		err := copier.Copy([]int{1}, &to, true)
+		assert.NoError(t, err)
+		assert.Equal(t, &[2]int{1, 0}, to)
+
+		to = nil
+		err = copier.CopyWith([]int{1, 2, 3}, &to, converter.OnArrayLength(converter.Exact))
+		assert.EqualError(
+			t,
+			err,
+			"cannot convert []int to *[2]int: cannot convert []int to [2]int: source length 3 exceeds target length 2",
+		)
+		assert.Nil(t, to)
+	})
+}
+
 type car struct {
 	age int
 }
@@ -23,6 +23,7 @@ package copier_test
 import (
 	"fmt"
 
+	"github.com/gontainer/reflectpro/converter"
 	"github.com/gontainer/reflectpro/copier"
 )
 
@@ -58,6 +59,33 @@ func ExampleCopy_convertMap() {
 	// <nil>
 }
 
+func ExampleCopy_convertStruct() {
+	type (
+		PersonDTO struct {
+			FullName string `reflectpro:"Name"`
+			Age      string
+		}
+		Person struct {
+			Name string
+			Age  int
+		}
+	)
+
+	var (
+		from = PersonDTO{FullName: "Jane", Age: "30"}
+		to   Person // fields are matched by names
+	)
+
+	err := copier.Copy(from, &to, true)
+
+	fmt.Printf("%+v\n", to)
+	fmt.Println(err)
+
+	// Output:
+	// {Name:Jane Age:30}
+	// <nil>
+}
+
 func ExampleCopy_ok() {
 	var (
 		from = 5 // the type of the variable `to` can be different from the type of the variable `from`
@@ -121,3 +149,16 @@ func ExampleCopy_err3() {
 	// <nil>
 	// value of type *int is not assignable to type *uint
 }
+
+func ExampleCopyWith() {
+	var to []uint8
+
+	err := copier.CopyWith([]int{1, 300}, &to, converter.Strict())
+
+	fmt.Println(to)
+	fmt.Println(err)
+
+	// Output:
+	// []
+	// cannot convert []int to []uint8: #1: cannot convert int to uint8: value 300 overflows uint8
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package copier
+
+import (
+	"github.com/gontainer/reflectpro/converter"
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+)
+
+/*
+CopyAs works similar to [CopyWith], but it returns the value converted to the type T.
+
+	ports, _ := copier.CopyAs[[]uint16]([]any{"80", 443})
+	fmt.Println(ports)
+	// Output: [80 443]
+*/
+func CopyAs[T any](from any, opts ...converter.Option) (T, error) {
+	return intReflect.As[T](from, intReflect.NewOptions(opts...)) //nolint:wrapcheck
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package copier_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/gontainer/reflectpro/converter"
+	"github.com/gontainer/reflectpro/copier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyAs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := copier.CopyAs[map[string]uint](map[string]any{"a": "1", "b": 2})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uint{"a": 1, "b": 2}, r)
+	})
+	t.Run("Interface", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := copier.CopyAs[fmt.Stringer](nil)
+		require.NoError(t, err)
+		assert.Nil(t, r)
+	})
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := copier.CopyAs[uint](5, converter.AssignOnly())
+		assert.EqualError(t, err, "value of type int is not assignable to type uint")
+
+		_, err = copier.CopyAs[[]int]([]string{"1", "two"})
+		assert.EqualError(
+			t,
+			err,
+			`cannot convert []string to []int: #1: cannot convert string to int: parsing "two": invalid syntax`,
+		)
+
+		var convErr *converter.ConversionError
+		require.True(t, errors.As(err, &convErr))
+		assert.Equal(t, reflect.TypeOf(""), convErr.Innermost().From)
+		assert.Equal(t, "[1]", convErr.Path().String())
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package getter_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gontainer/reflectpro/converter"
+	"github.com/gontainer/reflectpro/getter"
+)
+
+func ExampleGetAs_ok() {
+	person := struct {
+		age uint8
+	}{
+		age: 30,
+	}
+	age, err := getter.GetAs[int](person, "age")
+	fmt.Println(age+1, err)
+	// Output: 31 <nil>
+}
+
+func ExampleGetAs_options() {
+	cfg := struct {
+		Timeout int
+	}{
+		Timeout: 90,
+	}
+	timeout, err := getter.GetAs[time.Duration](cfg, "Timeout", converter.DurationUnit(time.Second))
+	fmt.Println(timeout, err)
+	// Output: 1m30s <nil>
+}
+
+func ExampleGetAs_error() {
+	person := struct {
+		name string
+	}{
+		name: "Mary",
+	}
+	_, err := getter.GetAs[int](person, "name")
+	fmt.Println(err)
+	// Output: get (struct { name string })."name": cannot convert string to int: parsing "Mary": invalid syntax
+}
@@ -23,6 +23,7 @@ package getter_test
 import (
 	"fmt"
 
+	"github.com/gontainer/reflectpro/converter"
 	"github.com/gontainer/reflectpro/getter"
 )
 
@@ -42,3 +43,140 @@ func ExampleGet_error() {
 	fmt.Println(err)
 	// Output: get (<nil>)."name": expected struct, <nil> given
 }
+
+func ExampleGet_path() {
+	type (
+		Server struct {
+			Host   string
+			Labels map[string]string
+		}
+		Config struct {
+			Servers []*Server
+		}
+	)
+
+	cfg := Config{
+		Servers: []*Server{
+			{Host: "localhost", Labels: map[string]string{"env": "prod"}},
+		},
+	}
+
+	host, _ := getter.Get(cfg, "Servers[0].Host")
+	env, _ := getter.Get(cfg, `Servers[0].Labels["env"]`)
+	_, err := getter.Get(cfg, "Servers[1].Host")
+
+	fmt.Println(host)
+	fmt.Println(env)
+	fmt.Println(err)
+
+	// Output:
+	// localhost
+	// prod
+	// get (getter_test.Config)."Servers[1].Host": Servers[1]: index out of range [1] with length 1
+}
+
+func ExampleGetByTag() {
+	type (
+		Audit struct {
+			CreatedBy string `json:"created_by"`
+		}
+		User struct {
+			Audit
+			Name string `json:"user_name,omitempty"`
+		}
+	)
+
+	u := User{Audit: Audit{CreatedBy: "admin"}, Name: "Mary"}
+
+	name, _ := getter.GetByTag(u, "json", "user_name")
+	createdBy, _ := getter.GetByTag(u, "json", "created_by")
+	_, err := getter.GetByTag(u, "json", "Name")
+
+	fmt.Println(name)
+	fmt.Println(createdBy)
+	fmt.Println(err)
+
+	// Output:
+	// Mary
+	// admin
+	// get (getter_test.User) by tag json:"Name": field with tag json:"Name" does not exist
+}
+
+//nolint:lll
+func ExampleGetWith() {
+	cfg := struct {
+		MaxConns int
+		Max_Idle int //nolint:revive,stylecheck
+		MaxIdle  int
+	}{
+		MaxConns: 10,
+	}
+
+	v, _ := getter.GetWith(cfg, "max-conns", converter.MatchFieldNames(converter.MatchNormalized))
+	_, err := getter.GetWith(cfg, "max-idle", converter.MatchFieldNames(converter.MatchNormalized))
+
+	fmt.Println(v)
+	fmt.Println(err)
+
+	// Output:
+	// 10
+	// get (struct { MaxConns int; Max_Idle int; MaxIdle int })."max-idle": field "max-idle" matches many fields: "Max_Idle", "MaxIdle"
+}
+
+func ExampleFields() {
+	type (
+		Base struct {
+			ID int
+		}
+		User struct {
+			Base
+			Name     string `json:"name"`
+			password string
+		}
+	)
+
+	fields, _ := getter.Fields(User{Base: Base{ID: 5}, Name: "Mary", password: "secret"})
+	for _, f := range fields {
+		fmt.Printf(
+			"%s %v %s %q exported=%t embedded=%t %v\n",
+			f.Name, f.Index, f.Type, f.Tag, f.Exported, f.Embedded, f.Value,
+		)
+	}
+
+	// Output:
+	// Base [0] getter_test.Base "" exported=true embedded=true {5}
+	// ID [0 0] int "" exported=true embedded=false 5
+	// Name [1] string "json:\"name\"" exported=true embedded=false Mary
+	// password [2] string "" exported=false embedded=false secret
+}
+
+func ExampleGetByIndex() {
+	type (
+		Base struct {
+			Name string
+		}
+		User struct {
+			*Base
+			Name string
+			_    int32
+		}
+	)
+
+	u := User{Base: &Base{Name: "base"}, Name: "Mary"}
+
+	name, _ := getter.GetByIndex(u, []int{1})
+	shadowed, _ := getter.GetByIndex(u, []int{0, 0})
+	blank, _ := getter.GetByIndex(u, []int{2})
+	_, err := getter.GetByIndex(u, []int{3})
+
+	fmt.Println(name)
+	fmt.Println(shadowed)
+	fmt.Println(blank)
+	fmt.Println(err)
+
+	// Output:
+	// Mary
+	// base
+	// 0
+	// get (getter_test.User) by index [3]: field #3 does not exist in getter_test.User
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package getter
+
+import (
+	"fmt"
+
+	"github.com/gontainer/grouperror"
+	"github.com/gontainer/reflectpro/converter"
+	"github.com/gontainer/reflectpro/internal/reflect"
+)
+
+/*
+GetAs works similar to [Get], but it converts the value of the field to the type T.
+The given options are applied on top of the defaults, see [converter.SetDefaults].
+Use [errors.As] to get [converter.ConversionError] whenever the value cannot be converted.
+
+	person := struct {
+		age uint8
+	}{
+		age: 30,
+	}
+	age, _ := getter.GetAs[int](person, "age")
+	fmt.Println(age + 1)
+	// Output: 31
+*/
+func GetAs[T any](strct any, field string, opts ...converter.Option) (T, error) {
+	o := reflect.NewOptions(opts...)
+
+	v, err := reflect.GetWith(strct, field, o)
+	if err != nil {
+		var zero T
+
+		return zero, err //nolint:wrapcheck
+	}
+
+	r, err := reflect.As[T](v, o)
+	if err != nil {
+		//nolint:wrapcheck
+		return r, grouperror.Prefix(fmt.Sprintf("get (%T).%+q: ", strct, field), err)
+	}
+
+	return r, nil
+}
@@ -21,11 +21,15 @@
 package getter
 
 import (
+	"github.com/gontainer/reflectpro/converter"
 	"github.com/gontainer/reflectpro/internal/reflect"
 )
 
 /*
 Get returns the value of `field` of the `struct`. Unexported fields are supported.
+The field can be a path to a nested value, e.g. `Database.Pool.MaxConns`, `Servers[2].Host` or `Labels["env"]`.
+Paths traverse pointers, interfaces, slices, arrays and maps. Keys of maps are quoted the same way as in Go,
+and they are converted to the type of keys of the given map.
 
 	person := struct {
 		name string
@@ -39,3 +43,86 @@ Get returns the value of `field` of the `struct`. Unexported fields are supporte
 func Get(strct any, field string) (any, error) {
 	return reflect.Get(strct, field)
 }
+
+/*
+GetWith works similar to [Get], but it accepts options, e.g. [converter.MatchFieldNames].
+The given options are applied on top of the defaults, see [converter.SetDefaults].
+
+	cfg := struct {
+		MaxConns int
+	}{
+		MaxConns: 10,
+	}
+	v, _ := getter.GetWith(cfg, "max-conns", converter.MatchFieldNames(converter.MatchNormalized))
+	fmt.Println(v)
+	// Output: 10
+*/
+func GetWith(strct any, field string, opts ...converter.Option) (any, error) {
+	return reflect.GetWith(strct, field, reflect.NewOptions(opts...))
+}
+
+/*
+GetByTag works similar to [Get], but it finds the field by the name given in the struct tag `tagKey`,
+e.g. `json`, `yaml` or `env`. Options in tags, e.g. `omitempty`, are ignored.
+Fields of embedded structs are promoted the same way as in [encoding/json],
+fields without a name in the tag are found by their names, and the tag "-" excludes the field.
+Unexported fields are supported whenever the tag gives them a name.
+
+	type User struct {
+		Name string `json:"user_name,omitempty"`
+	}
+	v, _ := getter.GetByTag(User{Name: "Mary"}, "json", "user_name")
+	fmt.Println(v)
+	// Output: Mary
+*/
+func GetByTag(strct any, tagKey string, name string) (any, error) {
+	return reflect.GetByTag(strct, tagKey, name)
+}
+
+/*
+GetByIndex works similar to [Get], but it finds the field by the index sequence, see [reflect.Value.FieldByIndex].
+It allows for reading fields that cannot be referred to by names,
+e.g. blank fields, or fields shadowed by promoted ones.
+Pointers to embedded structs are dereferenced, see [Fields].
+
+	type Padded struct {
+		A int32
+		_ int32
+		B int64
+	}
+	v, _ := getter.GetByIndex(Padded{B: 5}, []int{2})
+	fmt.Println(v)
+	// Output: 5
+
+[reflect.Value.FieldByIndex]: https://pkg.go.dev/reflect#Value.FieldByIndex
+*/
+func GetByIndex(strct any, index []int) (any, error) {
+	return reflect.GetByIndex(strct, index)
+}
+
+// Field describes a field of a struct, see [Fields].
+type Field = reflect.Field
+
+/*
+Fields returns fields of the given struct in the order of declaration, including unexported and embedded ones.
+Fields of embedded structs follow the embedded field, and [Field.Index] refers to the nested field,
+see [GetByIndex]. Fields of nil embedded pointers are omitted.
+
+	person := struct {
+		Name string `json:"name"`
+		age  int
+	}{
+		Name: "Mary",
+		age:  30,
+	}
+	fields, _ := getter.Fields(person)
+	for _, f := range fields {
+		fmt.Println(f.Name, f.Index, f.Type, f.Exported, f.Value)
+	}
+	// Output:
+	// Name [0] string true Mary
+	// age [1] int false 30
+*/
+func Fields(strct any) ([]Field, error) {
+	return reflect.Fields(strct)
+}
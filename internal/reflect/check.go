@@ -0,0 +1,187 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Verdict tells whether values of one type can be converted to another one, see [CanConvert].
+type Verdict uint8
+
+const (
+	// No means that values of the given type cannot be converted.
+	No Verdict = iota
+	// Maybe means that the result depends on the value, e.g. on the content of a string,
+	// or on the dynamic type of an interface.
+	Maybe
+	// Yes means that all values of the given type can be converted.
+	Yes
+)
+
+func (v Verdict) String() string {
+	switch v {
+	case No:
+		return "no"
+	case Maybe:
+		return "maybe"
+	case Yes:
+		return "yes"
+	}
+
+	return fmt.Sprintf("Verdict(%d)", uint8(v))
+}
+
+/*
+CanConvert checks whether values of the type `from` can be converted to the type `to`, without a value.
+It walks the same chain of converters as [ValueOf]. The reason explains verdicts other than [Yes].
+
+Custom converters registered by [RegisterConverter] cannot be inspected without a value,
+so they may turn [No] into [Maybe].
+*/
+func CanConvert(from, to reflect.Type, opts Options) (_ Verdict, reason string) {
+	if opts.AssignOnly {
+		return checkAssignable(from, to)
+	}
+
+	c := conversion{opts: opts}
+
+	return c.check(from, to)
+}
+
+// checker is implemented by converters able to decide whether they support the given types without a value.
+type checker interface {
+	check(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string)
+}
+
+type checkerFn func(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string)
+
+// builtInConverter binds a converter with its type-level counterpart.
+type builtInConverter struct {
+	convertFn converterFn
+	checkFn   checkerFn
+}
+
+func (b builtInConverter) convert(
+	from reflect.Value,
+	to reflect.Type,
+	c *conversion,
+) (
+	_ reflect.Value,
+	supports bool,
+	_ error,
+) {
+	return b.convertFn(from, to, c)
+}
+
+func (b builtInConverter) check(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	return b.checkFn(from, to, c)
+}
+
+type typePair struct {
+	from, to reflect.Type
+}
+
+func (c *conversion) check(from, to reflect.Type) (_ Verdict, reason string) {
+	if from.Kind() == reflect.Interface {
+		if from.AssignableTo(to) {
+			return Yes, ""
+		}
+
+		return Maybe, fmt.Sprintf("the dynamic type of %s is known at runtime only", from.String())
+	}
+
+	// recursive types are assumed to be convertible, the verdict depends on the remaining fields
+	pair := typePair{from: from, to: to}
+	if _, ok := c.checking[pair]; ok {
+		return Yes, ""
+	}
+
+	if c.checking == nil {
+		c.checking = make(map[typePair]struct{})
+	}
+
+	c.checking[pair] = struct{}{}
+	defer delete(c.checking, pair)
+
+	opaque := false
+
+	for _, group := range c.converters() {
+		for _, cv := range group {
+			ch, ok := cv.(checker)
+			if !ok {
+				opaque = true
+
+				continue
+			}
+
+			if v, supports, reason := ch.check(from, to, c); supports {
+				if v == No && opaque {
+					return Maybe, reason + "; custom converters are decided at runtime"
+				}
+
+				return v, reason
+			}
+		}
+	}
+
+	if opaque {
+		return Maybe, fmt.Sprintf("custom converters may convert %s to %s at runtime", from.String(), to.String())
+	}
+
+	return No, fmt.Sprintf("cannot convert %s to %s", from.String(), to.String())
+}
+
+// worse returns the worse of the given verdicts, and its reason.
+func worse(v1 Verdict, reason1 string, v2 Verdict, reason2 string) (Verdict, string) {
+	if v2 < v1 {
+		return v2, reason2
+	}
+
+	return v1, reason1
+}
+
+func prefixReason(prefix string, reason string) string {
+	if reason == "" {
+		return ""
+	}
+
+	return prefix + ": " + reason
+}
+
+// implementsType returns true if the given type, or the pointer to it, implements the given interface.
+func implementsType(t reflect.Type, iface reflect.Type) bool {
+	return t.Implements(iface) || (t.Kind() != reflect.Ptr && reflect.PtrTo(t).Implements(iface))
+}
+
+// checkAssignable checks values of the type `from` against the type `to` for [Options.AssignOnly].
+func checkAssignable(from, to reflect.Type) (_ Verdict, reason string) {
+	if from.AssignableTo(to) {
+		return Yes, ""
+	}
+
+	if from.Kind() == reflect.Interface {
+		return Maybe, fmt.Sprintf("the dynamic type of %s is known at runtime only", from.String())
+	}
+
+	return No, assignable(from, to).Error()
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"math/big"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+)
+
+type treeNode struct {
+	Value    int
+	Children []treeNode
+}
+
+type treeNodeDTO struct {
+	Value    string
+	Children []treeNodeDTO
+}
+
+func TestCanConvert(t *testing.T) {
+	t.Parallel()
+
+	typeOf := reflect.TypeOf
+
+	scenarios := map[string]struct {
+		from    reflect.Type
+		to      reflect.Type
+		opts    intReflect.Options
+		verdict intReflect.Verdict
+		reason  string
+	}{
+		"int to int": {
+			from:    typeOf(0),
+			to:      typeOf(0),
+			verdict: intReflect.Yes,
+		},
+		"int to float64": {
+			from:    typeOf(0),
+			to:      typeOf(float64(0)),
+			verdict: intReflect.Yes,
+		},
+		"int to string": {
+			from:    typeOf(0),
+			to:      typeOf(""),
+			verdict: intReflect.Yes,
+		},
+		"string to int": {
+			from:    typeOf(""),
+			to:      typeOf(0),
+			verdict: intReflect.Maybe,
+			reason:  "parsing int depends on the content of the string",
+		},
+		"struct{} to int": {
+			from:    typeOf(struct{}{}),
+			to:      typeOf(0),
+			verdict: intReflect.No,
+			reason:  "cannot convert struct {} to int",
+		},
+		"any to int": {
+			from:    typeOf((*any)(nil)).Elem(),
+			to:      typeOf(0),
+			verdict: intReflect.Maybe,
+			reason:  "the dynamic type of interface {} is known at runtime only",
+		},
+		"int to any": {
+			from:    typeOf(0),
+			to:      typeOf((*any)(nil)).Elem(),
+			verdict: intReflect.Yes,
+		},
+		"[]any to []int": {
+			from:    typeOf([]any{}),
+			to:      typeOf([]int{}),
+			verdict: intReflect.Maybe,
+			reason:  "element: the dynamic type of interface {} is known at runtime only",
+		},
+		"[]struct{} to [2]int": {
+			from:    typeOf([]struct{}{}),
+			to:      typeOf([2]int{}),
+			verdict: intReflect.No,
+			reason:  "element: cannot convert struct {} to int",
+		},
+		"map[string]int to map[int]string": {
+			from:    typeOf(map[string]int{}),
+			to:      typeOf(map[int]string{}),
+			verdict: intReflect.Maybe,
+			reason:  "map key: parsing int depends on the content of the string",
+		},
+		"map[int]struct{} to map[string]int": {
+			from:    typeOf(map[int]struct{}{}),
+			to:      typeOf(map[string]int{}),
+			verdict: intReflect.No,
+			reason:  "map value: cannot convert struct {} to int",
+		},
+		"int64 to int8 (strict)": {
+			from:    typeOf(int64(0)),
+			to:      typeOf(int8(0)),
+			opts:    intReflect.Options{Strict: true},
+			verdict: intReflect.Maybe,
+			reason:  "not all values of int64 can be represented exactly by int8",
+		},
+		"int32 to float64 (strict)": {
+			from:    typeOf(int32(0)),
+			to:      typeOf(float64(0)),
+			opts:    intReflect.Options{Strict: true},
+			verdict: intReflect.Yes,
+		},
+		"int to string (rune conversion)": {
+			from:    typeOf(0),
+			to:      typeOf(""),
+			opts:    intReflect.Options{RuneConversion: true},
+			verdict: intReflect.Yes,
+		},
+		"string to net.IP": {
+			from:    typeOf(""),
+			to:      typeOf(net.IP{}),
+			verdict: intReflect.Maybe,
+			reason:  "net.IP.UnmarshalText may reject the text",
+		},
+		"*big.Int to string": {
+			from:    typeOf(&big.Int{}),
+			to:      typeOf(""),
+			verdict: intReflect.Maybe,
+			reason:  "*big.Int.MarshalText may return an error",
+		},
+		"time.Duration to string": {
+			from:    typeOf(time.Duration(0)),
+			to:      typeOf(""),
+			verdict: intReflect.Yes,
+		},
+		"int64 to time.Time": {
+			from:    typeOf(int64(0)),
+			to:      typeOf(time.Time{}),
+			verdict: intReflect.Yes,
+		},
+		"float64 to time.Time": {
+			from:    typeOf(float64(0)),
+			to:      typeOf(time.Time{}),
+			verdict: intReflect.Maybe,
+			reason:  "non-integral values of float64 cannot be converted to time.Time",
+		},
+		"float64 to time.Duration": {
+			from:    typeOf(float64(0)),
+			to:      typeOf(time.Duration(0)),
+			opts:    intReflect.Options{DurationUnit: time.Second},
+			verdict: intReflect.Maybe,
+			reason:  "non-integral values of float64 cannot be converted to time.Duration",
+		},
+		"T to *T": {
+			from:    typeOf(pointerConfig{}),
+			to:      typeOf(&pointerConfig{}),
+			verdict: intReflect.Yes,
+		},
+		"*T to T": {
+			from:    typeOf(&pointerConfig{}),
+			to:      typeOf(pointerConfig{}),
+			verdict: intReflect.Maybe,
+			reason:  "nil *reflect_test.pointerConfig cannot be dereferenced",
+		},
+		"*float64 to *int": {
+			from:    typeOf(new(float64)),
+			to:      typeOf(new(int)),
+			verdict: intReflect.Yes,
+		},
+		"*string to *int": {
+			from:    typeOf(new(string)),
+			to:      typeOf(new(int)),
+			verdict: intReflect.Maybe,
+			reason:  "parsing int depends on the content of the string",
+		},
+		"struct to struct": {
+			from:    typeOf(personDTO{}),
+			to:      typeOf(personModel{}),
+			verdict: intReflect.Maybe,
+			reason:  `field "ID": parsing uint64 depends on the content of the string`,
+		},
+		"struct to struct (missing fields)": {
+			from:    typeOf(struct{ A int }{}),
+			to:      typeOf(struct{ A, B int }{}),
+			opts:    intReflect.Options{UnmatchedFields: intReflect.RejectMissingFields},
+			verdict: intReflect.No,
+			reason:  `fields without source in struct { A int; B int }: "B"`,
+		},
+		"recursive struct to struct": {
+			from:    typeOf(treeNodeDTO{}),
+			to:      typeOf(treeNode{}),
+			verdict: intReflect.Maybe,
+			reason:  `field "Value": parsing int depends on the content of the string`,
+		},
+		"map[string]string to struct": {
+			from:    typeOf(map[string]string{}),
+			to:      typeOf(databaseConfig{}),
+			verdict: intReflect.Maybe,
+			reason:  `field "Pool": cannot convert string to reflect_test.poolConfig`,
+		},
+		"map[string]any to struct": {
+			from:    typeOf(map[string]any{}),
+			to:      typeOf(config{}),
+			verdict: intReflect.Maybe,
+			reason:  `field "Name": the dynamic type of interface {} is known at runtime only`,
+		},
+		"struct to map[string]any": {
+			from:    typeOf(auditUser{}),
+			to:      typeOf(map[string]any{}),
+			verdict: intReflect.Yes,
+		},
+		"struct with interfaces to map[string]any": {
+			from:    typeOf(auditEntry{}),
+			to:      typeOf(map[string]any{}),
+			verdict: intReflect.Maybe,
+			reason:  `field "Labels": map value: the dynamic type of interface {} is known at runtime only`,
+		},
+		"struct with struct keys to map[string]any": {
+			from:    typeOf(struct{ Counts map[struct{ A int }]int }{}),
+			to:      typeOf(map[string]any{}),
+			verdict: intReflect.No,
+			reason:  `field "Counts": map key: cannot convert struct { A int } to string`,
+		},
+		"struct to map[string]string": {
+			from:    typeOf(auditUser{}),
+			to:      typeOf(map[string]string{}),
+			verdict: intReflect.Yes,
+		},
+		"struct to map[string]int": {
+			from:    typeOf(auditUser{}),
+			to:      typeOf(map[string]int{}),
+			verdict: intReflect.Maybe,
+			reason:  `field "Roles": element: parsing int depends on the content of the string`,
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			verdict, reason := intReflect.CanConvert(s.from, s.to, s.opts)
+			assert.Equal(t, s.verdict.String(), verdict.String())
+			assert.Equal(t, s.reason, reason)
+		})
+	}
+}
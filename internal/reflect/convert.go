@@ -21,21 +21,19 @@
 package reflect
 
 import (
-	"fmt"
 	"reflect"
 )
 
-// generalConverter is an alias for a signature of the [convert] function.
 type generalConverter = func(value any, to reflect.Type) (reflect.Value, error)
 
 type converter interface {
-	convert(from reflect.Value, to reflect.Type, convert generalConverter) (_ reflect.Value, supports bool, _ error)
+	convert(from reflect.Value, to reflect.Type, c *conversion) (_ reflect.Value, supports bool, _ error)
 }
 
 type converterFn func(
 	from reflect.Value,
 	to reflect.Type,
-	convert generalConverter,
+	c *conversion,
 ) (
 	_ reflect.Value,
 	supports bool,
@@ -45,41 +43,133 @@ type converterFn func(
 func (fn converterFn) convert(
 	from reflect.Value,
 	to reflect.Type,
-	convert generalConverter,
+	c *conversion,
 ) (
 	_ reflect.Value,
 	supports bool,
 	_ error,
 ) {
-	return fn(from, to, convert)
+	return fn(from, to, c)
+}
+
+// customConverter adapts [ConverterFunc] to the interface [converter].
+type customConverter ConverterFunc
+
+func (fn customConverter) convert(
+	from reflect.Value,
+	to reflect.Type,
+	c *conversion,
+) (
+	_ reflect.Value,
+	supports bool,
+	_ error,
+) {
+	return fn(from, to, c.convert)
 }
 
 //nolint:gochecknoglobals
 var (
-	converters = []converter{
-		converterFn(convertBuiltIn),
-		converterFn(convertSlice),
-		converterFn(convertMap),
-	}
+	builtInConverters []converter
 )
 
-// convert converts given value to given type whenever it is possible.
-// In opposition to built-in reflect package it can convert slices and maps.
-func convert(value any, to reflect.Type) (reflect.Value, error) {
+// builtInConverters cannot be initialized in its declaration, because they refer to it recursively.
+//
+//nolint:gochecknoinits
+func init() {
+	builtInConverters = []converter{
+		builtInConverter{convertFn: convertTime, checkFn: checkTime},
+		builtInConverter{convertFn: convertText, checkFn: checkText},
+		builtInConverter{convertFn: convertString, checkFn: checkString},
+		builtInConverter{convertFn: convertBuiltIn, checkFn: checkBuiltIn},
+		builtInConverter{convertFn: convertSlice, checkFn: checkSlice},
+		builtInConverter{convertFn: convertMap, checkFn: checkMap},
+		builtInConverter{convertFn: convertStruct, checkFn: checkStruct},
+		builtInConverter{convertFn: convertMapToStruct, checkFn: checkMapToStruct},
+		builtInConverter{convertFn: convertStructToMap, checkFn: checkStructToMap},
+		builtInConverter{convertFn: convertFunc, checkFn: checkFunc},
+		builtInConverter{convertFn: convertPointer, checkFn: checkPointer},
+	}
+}
+
+// converters returns all converters in the order they are applied,
+// see [RegisterConverter] and [Options.BeforeBuiltIn].
+func (c *conversion) converters() [][]converter {
+	before, after := registeredConverters()
+
+	return [][]converter{
+		customConverters(c.opts.BeforeBuiltIn),
+		before,
+		builtInConverters,
+		customConverters(c.opts.AfterBuiltIn),
+		after,
+	}
+}
+
+func customConverters(fns []ConverterFunc) []converter {
+	if len(fns) == 0 {
+		return nil
+	}
+
+	r := make([]converter, len(fns))
+	for i, fn := range fns {
+		r[i] = customConverter(fn)
+	}
+
+	return r
+}
+
+// hasCustomConverters returns true if the options define converters for the given conversion only.
+func (o Options) hasCustomConverters() bool {
+	return len(o.BeforeBuiltIn) > 0 || len(o.AfterBuiltIn) > 0
+}
+
+// conversion holds the options of a single conversion, and passes them to nested conversions.
+type conversion struct {
+	opts     Options
+	checking map[typePair]struct{}
+	plans    map[typePair]*plan
+	visiting map[visitKey]reflect.Value
+}
+
+func convert(value any, to reflect.Type, opts Options) (reflect.Value, error) {
+	c := conversion{opts: opts}
+
+	return c.convert(value, to)
+}
+
+func (c *conversion) convert(value any, to reflect.Type) (reflect.Value, error) {
 	from := reflect.ValueOf(value)
 	if !from.IsValid() {
-		return zeroForNilable(value, to)
+		return zeroForNil(value, to, c.opts.NilAsZero)
+	}
+
+	return c.apply(from, to, c.plan(from.Type(), to))
+}
+
+// convertElem works similar to [conversion.convert], but it applies the given plan, see [conversion.elemPlan].
+func (c *conversion) convertElem(value any, to reflect.Type, p *plan) (reflect.Value, error) {
+	if p == nil {
+		return c.convert(value, to)
 	}
 
-	for _, c := range converters {
-		if v, supports, err := c.convert(from, to, convert); supports {
-			if err != nil {
-				err = fmt.Errorf("cannot convert %s to %s: %w", from.Type().String(), to.String(), err)
-			}
+	return c.apply(reflect.ValueOf(value), to, p)
+}
 
-			return v, err
+// apply returns the result of the first converter of the given plan that supports the given value.
+func (c *conversion) apply(from reflect.Value, to reflect.Type, p *plan) (reflect.Value, error) {
+	for _, cv := range p.converters {
+		if v, supports, err := cv.convert(from, to, c); supports {
+			return v, conversionError(from.Type(), to, err)
 		}
 	}
 
-	return reflect.Value{}, fmt.Errorf("cannot convert %s to %s", from.Type().String(), to.String())
+	return reflect.Value{}, &ConversionError{From: from.Type(), To: to}
+}
+
+func conversionError(from, to reflect.Type, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &ConversionError{From: from, To: to, Err: err}
 }
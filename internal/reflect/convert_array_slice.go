@@ -21,6 +21,7 @@
 package reflect
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 )
@@ -28,14 +29,14 @@ import (
 func convertSlice(
 	from reflect.Value,
 	to reflect.Type,
-	convert generalConverter,
+	c *conversion,
 ) (
 	_ reflect.Value,
 	supports bool,
 	_ error,
 ) {
 	if isConvertibleSliceOrArray(from, to) {
-		v, err := convertSliceOrArray(from, to, convert)
+		v, err := convertSliceOrArray(from, to, c)
 
 		return v, true, err
 	}
@@ -43,6 +44,27 @@ func convertSlice(
 	return reflect.Value{}, false, nil
 }
 
+func checkSlice(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	if !isArrayOrSlice(from.Kind()) || !isArrayOrSlice(to.Kind()) {
+		return No, false, ""
+	}
+
+	v, reason := c.check(from.Elem(), to.Elem())
+	reason = prefixReason("element", reason)
+
+	if to.Kind() == reflect.Array && c.opts.ArrayLength != TruncateOrPad {
+		if from.Kind() == reflect.Array {
+			if err := c.opts.ArrayLength.check(from.Len(), to.Len()); err != nil {
+				return No, true, err.Error()
+			}
+		} else {
+			v, reason = worse(v, reason, Maybe, fmt.Sprintf("the length of %s is known at runtime only", from.String()))
+		}
+	}
+
+	return v, true, reason
+}
+
 func isArrayOrSlice(k reflect.Kind) bool {
 	switch k { //nolint:exhaustive
 	case
@@ -63,13 +85,16 @@ func isConvertibleSliceOrArray(from reflect.Value, to reflect.Type) bool {
 }
 
 //nolint:cyclop
-func convertSliceOrArray(from reflect.Value, to reflect.Type, convert generalConverter) (reflect.Value, error) {
+func convertSliceOrArray(from reflect.Value, to reflect.Type, c *conversion) (reflect.Value, error) {
 	// check whether slice values are convertible for len == 0
-	if from.Len() == 0 && !isAny(from.Type().Elem()) && !isAny(to.Elem()) {
-		if _, err := convert(
-			reflect.Zero(from.Type().Elem()).Interface(),
-			to.Elem(),
-		); err != nil {
+	if from.Len() == 0 {
+		if v, reason := c.check(from.Type().Elem(), to.Elem()); v == No {
+			return reflect.Value{}, errors.New(reason)
+		}
+	}
+
+	if to.Kind() == reflect.Array {
+		if err := c.opts.ArrayLength.check(from.Len(), to.Len()); err != nil {
 			return reflect.Value{}, err
 		}
 	}
@@ -79,6 +104,12 @@ func convertSliceOrArray(from reflect.Value, to reflect.Type, convert generalCon
 		return reflect.Zero(to), nil
 	}
 
+	if from.Kind() == reflect.Slice {
+		if v, found, err := c.cycle(from, to); found {
+			return v, err
+		}
+	}
+
 	var (
 		cp    reflect.Value
 		toLen int
@@ -96,6 +127,18 @@ func convertSliceOrArray(from reflect.Value, to reflect.Type, convert generalCon
 		cp = reflect.MakeSlice(to, from.Len(), from.Len())
 	}
 
+	if from.Kind() == reflect.Slice {
+		result := cp
+		if to.Kind() == reflect.Array {
+			// arrays cannot refer to themselves
+			result = reflect.Value{}
+		}
+
+		defer c.leave(c.enter(from, to, result))
+	}
+
+	elemPlan := c.elemPlan(from.Type().Elem(), to.Elem())
+
 	for i := 0; i < toLen; i++ {
 		item := from.Index(i)
 		for item.Kind() == reflect.Interface {
@@ -108,9 +151,9 @@ func convertSliceOrArray(from reflect.Value, to reflect.Type, convert generalCon
 			currVal = item.Interface()
 		}
 
-		curr, err := convert(currVal, to.Elem())
+		curr, err := c.convertElem(currVal, to.Elem(), elemPlan)
 		if err != nil {
-			return reflect.Value{}, fmt.Errorf("#%d: %w", i, err)
+			return reflect.Value{}, indexError(i, err)
 		}
 
 		cp.Index(i).Set(curr)
@@ -0,0 +1,206 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"reflect"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueOf_arrayLength(t *testing.T) {
+	t.Parallel()
+
+	const (
+		longer  = "longer"
+		shorter = "shorter"
+		equal   = "equal"
+	)
+
+	inputs := map[string]any{
+		longer:  []byte{1, 2, 3, 4, 5},
+		shorter: [2]int{1, 2},
+		equal:   []any{1, 2, 3, 4},
+	}
+
+	outputs := map[string][4]byte{
+		longer:  {1, 2, 3, 4},
+		shorter: {1, 2, 0, 0},
+		equal:   {1, 2, 3, 4},
+	}
+
+	errs := map[string]string{
+		longer:  "cannot convert []uint8 to [4]uint8: source length 5 exceeds target length 4",
+		shorter: "cannot convert [2]int to [4]uint8: source length 2 is less than target length 4",
+	}
+
+	scenarios := map[string]struct {
+		policy   intReflect.ArrayLength
+		rejected []string
+	}{
+		"TruncateOrPad": {
+			policy: intReflect.TruncateOrPad,
+		},
+		"Truncate": {
+			policy:   intReflect.Truncate,
+			rejected: []string{shorter},
+		},
+		"Pad": {
+			policy:   intReflect.Pad,
+			rejected: []string{longer},
+		},
+		"Exact": {
+			policy:   intReflect.Exact,
+			rejected: []string{longer, shorter},
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			opts := intReflect.Options{ArrayLength: s.policy}
+
+			for name, input := range inputs {
+				v, err := intReflect.ValueOfWith(input, reflect.TypeOf([4]byte{}), opts)
+
+				rejected := false
+
+				for _, r := range s.rejected {
+					if r == name {
+						rejected = true
+					}
+				}
+
+				if rejected {
+					assert.EqualError(t, err, errs[name], name)
+
+					continue
+				}
+
+				require.NoError(t, err, name)
+				assert.Equal(t, outputs[name], v.Interface(), name)
+			}
+		})
+	}
+}
+
+func TestValueOf_arrayLengthNested(t *testing.T) {
+	t.Parallel()
+
+	opts := intReflect.Options{ArrayLength: intReflect.Exact}
+
+	_, err := intReflect.ValueOfWith(map[string][]byte{"key": {1, 2}}, reflect.TypeOf(map[string][32]byte{}), opts)
+	assert.EqualError(
+		t,
+		err,
+		"cannot convert map[string][]uint8 to map[string][32]uint8: map value: "+
+			"cannot convert []uint8 to [32]uint8: source length 2 is less than target length 32",
+	)
+
+	_, err = intReflect.ValueOfWith([]byte(nil), reflect.TypeOf([1]byte{}), opts)
+	assert.EqualError(t, err, "cannot convert []uint8 to [1]uint8: source length 0 is less than target length 1")
+
+	v, err := intReflect.ValueOfWith([3]int{1, 2, 3}, reflect.TypeOf([3]int{}), opts)
+	require.NoError(t, err)
+	assert.Equal(t, [3]int{1, 2, 3}, v.Interface())
+
+	verdict, reason := intReflect.CanConvert(reflect.TypeOf([2]int{}), reflect.TypeOf([3]int{}), opts)
+	assert.Equal(t, intReflect.No, verdict)
+	assert.Equal(t, "source length 2 is less than target length 3", reason)
+
+	verdict, reason = intReflect.CanConvert(reflect.TypeOf([]int{}), reflect.TypeOf([3]int{}), opts)
+	assert.Equal(t, intReflect.Maybe, verdict)
+	assert.Equal(t, "the length of []int is known at runtime only", reason)
+}
+
+func TestValueOfWith_sliceToArrayPointer(t *testing.T) {
+	t.Parallel()
+
+	scenarios := map[string]struct {
+		input  []int
+		policy intReflect.ArrayLength
+		output *[2]int
+		error  string
+	}{
+		"shorter": {
+			input:  []int{1},
+			output: &[2]int{1, 0},
+		},
+		"longer": {
+			input:  []int{1, 2, 3},
+			output: &[2]int{1, 2},
+		},
+		"equal": {
+			input:  []int{1, 2},
+			policy: intReflect.Exact,
+			output: &[2]int{1, 2},
+		},
+		"shorter (Exact)": {
+			input:  []int{1},
+			policy: intReflect.Exact,
+			error:  "cannot convert []int to *[2]int: cannot convert []int to [2]int: source length 1 is less than target length 2", //nolint:lll
+		},
+		"longer (Exact)": {
+			input:  []int{1, 2, 3},
+			policy: intReflect.Exact,
+			error:  "cannot convert []int to *[2]int: cannot convert []int to [2]int: source length 3 exceeds target length 2",
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			opts := intReflect.DefaultOptions()
+			opts.ArrayLength = s.policy
+
+			v, err := intReflect.ValueOfWith(s.input, reflect.TypeOf((*[2]int)(nil)), opts)
+			if s.error != "" {
+				assert.EqualError(t, err, s.error)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.output, v.Interface())
+		})
+	}
+
+	t.Run("CanConvert", func(t *testing.T) {
+		t.Parallel()
+
+		verdict, reason := intReflect.CanConvert(
+			reflect.TypeOf([]int{}),
+			reflect.TypeOf((*[2]int)(nil)),
+			intReflect.Options{ArrayLength: intReflect.Exact},
+		)
+		assert.Equal(t, intReflect.Maybe, verdict)
+		assert.Equal(t, "the length of []int is known at runtime only", reason)
+	})
+}
@@ -21,17 +21,23 @@
 package reflect
 
 import (
+	"fmt"
 	"reflect"
 )
 
-func convertBuiltIn(from reflect.Value, to reflect.Type, _ generalConverter) (_ reflect.Value, supports bool, _ error) {
-	// avoid a panic, see [reflect.Type.ConvertibleTo]
-	if to.Kind() == reflect.Array &&
-		(from.Kind() == reflect.Slice || from.Kind() == reflect.Array) &&
-		from.Len() < to.Len() {
+func convertBuiltIn(from reflect.Value, to reflect.Type, c *conversion) (_ reflect.Value, supports bool, _ error) {
+	// arrays are converted by [convertSlice] according to [Options.ArrayLength],
+	// it also avoids a panic, see [reflect.Type.ConvertibleTo]
+	if isArrayConversion(from.Type(), to) {
 		return reflect.Value{}, false, nil
 	}
 
+	if c.opts.Strict && isNumber(from.Kind()) && isNumber(to.Kind()) {
+		v, err := convertNumberStrictly(from, to)
+
+		return v, true, err
+	}
+
 	if from.Type().ConvertibleTo(to) {
 		return from.Convert(to), true, nil
 	}
@@ -39,6 +45,38 @@ func convertBuiltIn(from reflect.Value, to reflect.Type, _ generalConverter) (_
 	return reflect.Value{}, false, nil
 }
 
+func checkBuiltIn(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	if isArrayConversion(from, to) {
+		return No, false, ""
+	}
+
+	if c.opts.Strict && isNumber(from.Kind()) && isNumber(to.Kind()) {
+		if isExactNumberConversion(from, to) {
+			return Yes, true, ""
+		}
+
+		return Maybe, true, fmt.Sprintf("not all values of %s can be represented exactly by %s", from.String(), to.String())
+	}
+
+	if from.ConvertibleTo(to) {
+		return Yes, true, ""
+	}
+
+	return No, false, ""
+}
+
+/*
+isArrayConversion returns true when slices or arrays are converted to arrays of different types,
+or slices are converted to pointers to arrays. Pointers to arrays are allocated by [convertPointer] then.
+*/
+func isArrayConversion(from, to reflect.Type) bool {
+	if from.Kind() == reflect.Slice && to.Kind() == reflect.Ptr {
+		to = to.Elem()
+	}
+
+	return to.Kind() == reflect.Array && isArrayOrSlice(from.Kind()) && from != to
+}
+
 /*
 isAny returns true for any interface with zero methods:
 
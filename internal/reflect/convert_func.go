@@ -0,0 +1,137 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+/*
+convertFunc wraps functions using [reflect.MakeFunc] to adapt them to another signature, e.g.:
+
+	func(int) int => func(int64) int64
+	func(int) int => func(any) any
+
+The wrapper converts the given arguments to the parameter types of the original function,
+and the returned values to the result types of the target one.
+Both functions must have the same number of parameters and results, and must be both either variadic or not.
+Types of parameters and results that can never be converted are rejected at conversion time, see [CanConvert].
+The wrapper panics whenever it cannot convert an argument or a result.
+*/
+func convertFunc(from reflect.Value, to reflect.Type, c *conversion) (_ reflect.Value, supports bool, _ error) {
+	if from.Kind() != reflect.Func || to.Kind() != reflect.Func {
+		return reflect.Value{}, false, nil
+	}
+
+	if v, reason := checkSignatures(from.Type(), to, c); v == No {
+		return reflect.Value{}, true, errors.New(reason)
+	}
+
+	if from.IsNil() {
+		return reflect.Zero(to), true, nil
+	}
+
+	opts := c.opts
+
+	fn := reflect.MakeFunc(to, func(args []reflect.Value) []reflect.Value {
+		c := conversion{opts: opts}
+
+		in, err := convertValues(args, from.Type().In, "argument", &c)
+		if err != nil {
+			panic(fmt.Errorf("cannot call %s: %w", from.Type().String(), err))
+		}
+
+		var out []reflect.Value
+		if from.Type().IsVariadic() {
+			out = from.CallSlice(in)
+		} else {
+			out = from.Call(in)
+		}
+
+		results, err := convertValues(out, to.Out, "result", &c)
+		if err != nil {
+			panic(fmt.Errorf("cannot call %s: %w", from.Type().String(), err))
+		}
+
+		return results
+	})
+
+	return fn, true, nil
+}
+
+func convertValues(
+	values []reflect.Value,
+	typeOf func(int) reflect.Type,
+	name string,
+	c *conversion,
+) ([]reflect.Value, error) {
+	r := make([]reflect.Value, len(values))
+
+	for i, v := range values {
+		var err error
+
+		if r[i], err = c.convert(v.Interface(), typeOf(i)); err != nil {
+			return nil, fmt.Errorf("%s #%d: %w", name, i, err)
+		}
+	}
+
+	return r, nil
+}
+
+func checkFunc(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	if from.Kind() != reflect.Func || to.Kind() != reflect.Func {
+		return No, false, ""
+	}
+
+	v, reason := checkSignatures(from, to, c)
+
+	return v, true, reason
+}
+
+// checkSignatures checks whether the function of the type `from` can be wrapped by the one of the type `to`.
+func checkSignatures(from, to reflect.Type, c *conversion) (_ Verdict, reason string) {
+	switch {
+	case from.NumIn() != to.NumIn():
+		return No, fmt.Sprintf("%s has %d parameter(s), %d expected", from.String(), from.NumIn(), to.NumIn())
+
+	case from.NumOut() != to.NumOut():
+		return No, fmt.Sprintf("%s has %d result(s), %d expected", from.String(), from.NumOut(), to.NumOut())
+
+	case from.IsVariadic() != to.IsVariadic():
+		return No, fmt.Sprintf("both %s and %s must be either variadic or not", from.String(), to.String())
+	}
+
+	v := Yes
+
+	for i := 0; i < from.NumIn(); i++ {
+		pv, pr := c.check(to.In(i), from.In(i))
+		v, reason = worse(v, reason, pv, prefixReason(fmt.Sprintf("argument #%d", i), pr))
+	}
+
+	for i := 0; i < from.NumOut(); i++ {
+		rv, rr := c.check(from.Out(i), to.Out(i))
+		v, reason = worse(v, reason, rv, prefixReason(fmt.Sprintf("result #%d", i), rr))
+	}
+
+	return v, reason
+}
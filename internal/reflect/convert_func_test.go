@@ -0,0 +1,150 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueOf_func(t *testing.T) {
+	t.Parallel()
+
+	double := func(i int) int {
+		return i * 2
+	}
+
+	t.Run("func(int) int to func(int64) int64", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := intReflect.ValueOf(double, reflect.TypeOf(func(int64) int64 { return 0 }), true)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), v.Interface().(func(int64) int64)(5)) //nolint:forcetypeassert
+	})
+	t.Run("func(int) int to func(any) any", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := intReflect.ValueOf(double, reflect.TypeOf(func(any) any { return nil }), true)
+		require.NoError(t, err)
+
+		fn := v.Interface().(func(any) any) //nolint:forcetypeassert
+		assert.Equal(t, 10, fn(5))
+		assert.Equal(t, 10, fn("5"))
+		assert.PanicsWithError(
+			t,
+			`cannot call func(int) int: argument #0: cannot convert string to int: parsing "five": invalid syntax`,
+			func() {
+				fn("five")
+			},
+		)
+	})
+	t.Run("Variadic", func(t *testing.T) {
+		t.Parallel()
+
+		join := func(sep string, parts ...string) string {
+			return strings.Join(parts, sep)
+		}
+
+		v, err := intReflect.ValueOf(join, reflect.TypeOf(func(string, ...int) any { return nil }), true)
+		require.NoError(t, err)
+		assert.Equal(t, "1-2-3", v.Interface().(func(string, ...int) any)("-", 1, 2, 3)) //nolint:forcetypeassert
+	})
+	t.Run("Results", func(t *testing.T) {
+		t.Parallel()
+
+		parse := func(s string) (any, error) {
+			if s == "" {
+				return nil, errors.New("empty string")
+			}
+
+			return s, nil
+		}
+
+		v, err := intReflect.ValueOf(parse, reflect.TypeOf(func(string) (int, error) { return 0, nil }), true)
+		require.NoError(t, err)
+
+		fn := v.Interface().(func(string) (int, error)) //nolint:forcetypeassert
+
+		i, err := fn("7")
+		require.NoError(t, err)
+		assert.Equal(t, 7, i)
+
+		assert.PanicsWithError(
+			t,
+			`cannot call func(string) (interface {}, error): result #0: cannot convert <nil> to int`,
+			func() {
+				_, _ = fn("")
+			},
+		)
+	})
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		var fn func(int) int
+
+		v, err := intReflect.ValueOf(fn, reflect.TypeOf(func(int64) int64 { return 0 }), true)
+		require.NoError(t, err)
+		assert.True(t, v.IsNil())
+	})
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		scenarios := map[string]struct {
+			to    any
+			error string
+		}{
+			"Number of parameters": {
+				to:    func(int, int) int { return 0 },
+				error: "cannot convert func(int) int to func(int, int) int: func(int) int has 1 parameter(s), 2 expected",
+			},
+			"Number of results": {
+				to:    func(int) {},
+				error: "cannot convert func(int) int to func(int): func(int) int has 1 result(s), 0 expected",
+			},
+			"Variadic": {
+				to: func(...int) int { return 0 },
+				error: "cannot convert func(int) int to func(...int) int: " +
+					"both func(int) int and func(...int) int must be either variadic or not",
+			},
+			"Types": {
+				to:    func(struct{}) int { return 0 },
+				error: "cannot convert func(int) int to func(struct {}) int: argument #0: cannot convert struct {} to int",
+			},
+		}
+
+		for n, s := range scenarios {
+			s := s
+
+			t.Run(n, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := intReflect.ValueOf(double, reflect.TypeOf(s.to), true)
+				assert.EqualError(t, err, s.error)
+			})
+		}
+	})
+}
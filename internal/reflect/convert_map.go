@@ -23,13 +23,15 @@ package reflect
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 )
 
 //nolint:cyclop
 func convertMap(
 	from reflect.Value,
 	to reflect.Type,
-	convert generalConverter,
+	c *conversion,
 ) (
 	_ reflect.Value,
 	supports bool,
@@ -40,23 +42,13 @@ func convertMap(
 		return //nolint:nakedret,nlreturn
 	}
 
-	if from.Len() == 0 { //nolint:nestif
-		if !isAny(from.Type().Key()) || !isAny(to.Key()) {
-			if _, err := convert(
-				reflect.Zero(from.Type().Key()).Interface(),
-				to.Key(),
-			); err != nil {
-				return reflect.Value{}, true, fmt.Errorf("non convertible keys: %w", err)
-			}
+	if from.Len() == 0 {
+		if v, reason := c.check(from.Type().Key(), to.Key()); v == No {
+			return reflect.Value{}, true, fmt.Errorf("non convertible keys: %s", reason)
 		}
 
-		if !isAny(from.Type().Elem()) || !isAny(to.Elem()) {
-			if _, err := convert(
-				reflect.Zero(from.Type().Elem()).Interface(),
-				to.Elem(),
-			); err != nil {
-				return reflect.Value{}, true, fmt.Errorf("non convertible values: %w", err)
-			}
+		if v, reason := c.check(from.Type().Elem(), to.Elem()); v == No {
+			return reflect.Value{}, true, fmt.Errorf("non convertible values: %s", reason)
 		}
 	}
 
@@ -64,27 +56,152 @@ func convertMap(
 		return reflect.Zero(to), true, nil
 	}
 
-	mapType := reflect.MapOf(to.Key(), to.Elem())
-	result := reflect.MakeMapWithSize(mapType, from.Len())
+	if v, found, err := c.cycle(from, to); found {
+		return v, true, err
+	}
+
+	result := reflect.MakeMapWithSize(to, from.Len())
+
+	defer c.leave(c.enter(from, to, result))
+
+	// source keys of the entries in the result, tracked only when collisions are allowed
+	var origins map[any]reflect.Value
+	if c.opts.KeyCollisions != RejectKeyCollisions {
+		origins = make(map[any]reflect.Value, from.Len())
+	}
+
+	keyPlan := c.elemPlan(from.Type().Key(), to.Key())
+	valuePlan := c.elemPlan(from.Type().Elem(), to.Elem())
 
 	iter := from.MapRange()
 	for iter.Next() {
-		newKey, err := convert(iter.Key().Interface(), to.Key())
+		newKey, err := c.convertElem(iter.Key().Interface(), to.Key(), keyPlan)
 		if err != nil {
-			err = fmt.Errorf("map key: %w", err)
+			return reflect.Value{}, true, mapKeyError(iter.Key(), err)
+		}
+
+		if result.MapIndex(newKey).IsValid() {
+			if origins == nil {
+				return reflect.Value{}, true, mapKeyError(iter.Key(), keyCollisionError(from, newKey, c))
+			}
 
-			return reflect.Value{}, true, err
+			prev := origins[newKey.Interface()]
+			if (c.opts.KeyCollisions == FirstKeyWins) == lessKey(prev, iter.Key()) {
+				continue
+			}
 		}
 
-		newValue, err := convert(iter.Value().Interface(), to.Elem())
+		newValue, err := c.convertElem(iter.Value().Interface(), to.Elem(), valuePlan)
 		if err != nil {
-			err = fmt.Errorf("map value: %w", err)
-
-			return reflect.Value{}, true, err
+			return reflect.Value{}, true, mapValueError(iter.Key(), err)
 		}
 
 		result.SetMapIndex(newKey, newValue)
+
+		if origins != nil {
+			origins[newKey.Interface()] = iter.Key()
+		}
 	}
 
 	return result, true, nil
 }
+
+func checkMap(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	if from.Kind() != reflect.Map || to.Kind() != reflect.Map {
+		return No, false, ""
+	}
+
+	keys, keysReason := c.check(from.Key(), to.Key())
+	values, valuesReason := c.check(from.Elem(), to.Elem())
+	v, reason := worse(keys, prefixReason("map key", keysReason), values, prefixReason("map value", valuesReason))
+
+	return v, true, reason
+}
+
+// keyCollisionError returns the error that lists all keys of the given map converted to the given key.
+func keyCollisionError(from reflect.Value, newKey reflect.Value, c *conversion) error {
+	var keys []reflect.Value
+
+	iter := from.MapRange()
+	for iter.Next() {
+		if k, err := c.convert(iter.Key().Interface(), newKey.Type()); err == nil && k.Interface() == newKey.Interface() {
+			keys = append(keys, iter.Key())
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return lessKey(keys[i], keys[j])
+	})
+
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = formatKey(k)
+	}
+
+	return fmt.Errorf("keys %s collide as %s", strings.Join(names, ", "), formatKey(newKey))
+}
+
+func formatKey(k reflect.Value) string {
+	for k.Kind() == reflect.Interface && !k.IsNil() {
+		k = k.Elem()
+	}
+
+	if !k.IsValid() {
+		return "<nil>"
+	}
+
+	if k.Kind() == reflect.String {
+		return fmt.Sprintf("%s(%+q)", k.Type().String(), k.String())
+	}
+
+	return fmt.Sprintf("%s(%v)", k.Type().String(), k.Interface())
+}
+
+/*
+lessKey defines the order of keys of maps used by [FirstKeyWins] and [LastKeyWins].
+Numbers are ordered by value, strings and booleans lexicographically,
+other keys by the names of their types, and then by their string representation.
+*/
+func lessKey(a, b reflect.Value) bool {
+	for a.Kind() == reflect.Interface && !a.IsNil() {
+		a = a.Elem()
+	}
+
+	for b.Kind() == reflect.Interface && !b.IsNil() {
+		b = b.Elem()
+	}
+
+	if !a.IsValid() || !b.IsValid() {
+		return !a.IsValid() && b.IsValid()
+	}
+
+	ak, bk := a.Kind(), b.Kind()
+
+	switch {
+	case isNumber(ak) && isNumber(bk):
+		if af, bf := numberAsFloat(a), numberAsFloat(b); af != bf {
+			return af < bf
+		}
+	case ak == reflect.String && bk == reflect.String:
+		if a.String() != b.String() {
+			return a.String() < b.String()
+		}
+	}
+
+	if at, bt := a.Type().String(), b.Type().String(); at != bt {
+		return at < bt
+	}
+
+	return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+}
+
+func numberAsFloat(v reflect.Value) float64 {
+	switch {
+	case isInt(v.Kind()):
+		return float64(v.Int())
+	case isUint(v.Kind()):
+		return float64(v.Uint())
+	}
+
+	return v.Float()
+}
@@ -0,0 +1,311 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+/*
+convertMapToStruct decodes maps with string keys into structs, e.g.:
+
+	map[string]any{
+		"database": map[string]any{
+			"host": "localhost",
+			"pool": map[string]any{
+				"max": 10,
+			},
+		},
+	}
+
+Keys are matched to fields by names, see [Options.TagName] and [Options.CaseInsensitiveKeys].
+Fields of embedded structs are promoted the same way as in Go,
+nil pointers to embedded structs are allocated when needed.
+Nested maps are decoded into nested structs and pointers to structs. Unexported fields are supported.
+Fields and keys without counterparts are handled according to [Options.UnmatchedFields].
+Errors contain the full path of the key, e.g. "database.pool.max: cannot convert string to int".
+*/
+func convertMapToStruct(from reflect.Value, to reflect.Type, c *conversion) (_ reflect.Value, supports bool, _ error) {
+	if from.Kind() != reflect.Map || to.Kind() != reflect.Struct || !isStringKey(from.Type().Key()) {
+		return reflect.Value{}, false, nil
+	}
+
+	result := reflect.New(to).Elem()
+
+	if err := decodeMap(from, result, nil, c); err != nil {
+		return reflect.Value{}, true, err
+	}
+
+	return result, true, nil
+}
+
+func checkMapToStruct(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	if from.Kind() != reflect.Map || to.Kind() != reflect.Struct || !isStringKey(from.Key()) {
+		return No, false, ""
+	}
+
+	fields, err := cachedStructFields(to, c.opts.tagName())
+	if err != nil {
+		return No, true, err.Error()
+	}
+
+	v := Yes
+
+	switch {
+	case isAny(from.Key()):
+		v, reason = Maybe, fmt.Sprintf("keys of %s must be strings", from.String())
+	case c.opts.UnmatchedFields&RejectUnmatchedFields != 0:
+		v, reason = Maybe, "keys are known at runtime only"
+	}
+
+	for _, f := range fields.all() {
+		fv, fr := c.check(from.Elem(), f.Type)
+		if fv == No {
+			// the conversion fails only if the key is present
+			fv = Maybe
+		}
+
+		v, reason = worse(v, reason, fv, prefixReason(fmt.Sprintf("field %+q", f.Name), fr))
+	}
+
+	return v, true, reason
+}
+
+func isStringKey(t reflect.Type) bool {
+	return t.Kind() == reflect.String || isAny(t)
+}
+
+// decodeMap decodes the given map into the given addressable struct.
+func decodeMap(from reflect.Value, to reflect.Value, path []string, c *conversion) error { //nolint:cyclop
+	// values of structs cannot refer to themselves, see [decodeField] for pointers
+	if _, found, err := c.cycle(from, to.Type()); found {
+		if err == nil {
+			err = fmt.Errorf("cycle detected: %s refers to itself", from.Type().String())
+		}
+
+		return pathError(path, err)
+	}
+
+	defer c.leave(c.enter(from, to.Type(), to.Addr()))
+
+	fields, err := cachedStructFields(to.Type(), c.opts.tagName())
+	if err != nil {
+		return pathError(path, err)
+	}
+
+	keys, err := stringKeys(from)
+	if err != nil {
+		return pathError(path, err)
+	}
+
+	var (
+		used    = make(map[string]string, len(keys))
+		unknown []string
+	)
+
+	for _, k := range keys {
+		f, ok, err := fields.find(k.key, c.opts.CaseInsensitiveKeys)
+		if err != nil {
+			return pathError(path, err)
+		}
+
+		if !ok {
+			unknown = append(unknown, fmt.Sprintf("%+q", k.key))
+
+			continue
+		}
+
+		target := writeField(to, f.path)
+
+		// keys are sorted, so the previous key is the first one
+		if prev, ok := used[f.key]; ok {
+			switch c.opts.KeyCollisions {
+			case RejectKeyCollisions:
+				return pathError(path, fmt.Errorf("keys %+q and %+q match the same field %+q", prev, k.key, f.Name))
+			case FirstKeyWins:
+				continue
+			case LastKeyWins:
+				target.Set(reflect.Zero(target.Type()))
+			}
+		}
+
+		used[f.key] = k.key
+
+		if err := decodeField(from.MapIndex(k.value), target, appendPath(path, k.key), c); err != nil {
+			return err
+		}
+	}
+
+	var msgs []string
+
+	if c.opts.UnmatchedFields&RejectMissingFields != 0 {
+		var names []string
+
+		for _, f := range fields.all() {
+			if _, ok := used[f.key]; !ok && !f.embedsStruct() {
+				names = append(names, fmt.Sprintf("%+q", f.Name))
+			}
+		}
+
+		if len(names) > 0 {
+			msgs = append(msgs, fmt.Sprintf("fields without source in %s: %s", to.Type().String(), strings.Join(names, ", ")))
+		}
+	}
+
+	if c.opts.UnmatchedFields&RejectUnknownFields != 0 && len(unknown) > 0 {
+		msgs = append(msgs, fmt.Sprintf("keys without target in %s: %s", to.Type().String(), strings.Join(unknown, ", ")))
+	}
+
+	return pathError(path, joinMessages(msgs))
+}
+
+// decodeField decodes nested maps into structs directly to keep the full path in errors,
+// other values are converted using [conversion.convert].
+func decodeField(from reflect.Value, to reflect.Value, path []string, c *conversion) error {
+	for from.Kind() == reflect.Interface && !from.IsNil() {
+		from = from.Elem()
+	}
+
+	if from.Kind() == reflect.Map && isStringKey(from.Type().Key()) && !from.IsNil() {
+		// the pointer to the struct being decoded from the same map preserves the cycle
+		if to.Kind() == reflect.Ptr && to.Type().Elem().Kind() == reflect.Struct {
+			if v, found, err := c.cycle(from, to.Type().Elem()); found {
+				if err != nil {
+					return pathError(path, err)
+				}
+
+				if v.Type().AssignableTo(to.Type()) {
+					to.Set(v)
+
+					return nil
+				}
+
+				return pathError(path, fmt.Errorf("cycle detected: %s refers to itself", from.Type().String()))
+			}
+		}
+
+		if target, ok := structTarget(to); ok {
+			return decodeMap(from, target, path, c)
+		}
+	}
+
+	var value any
+	if from.IsValid() {
+		value = from.Interface()
+	}
+
+	v, err := c.convert(value, to.Type())
+	if err != nil {
+		return pathError(path, err)
+	}
+
+	to.Set(v)
+
+	return nil
+}
+
+// structTarget returns the struct for the given field of type struct or pointer to struct.
+// Nil pointers are initialized.
+func structTarget(v reflect.Value) (reflect.Value, bool) {
+	t := v.Type()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		v = v.Elem()
+	}
+
+	return v, true
+}
+
+type mapKey struct {
+	key   string
+	value reflect.Value
+}
+
+// stringKeys returns sorted keys of the given map.
+func stringKeys(m reflect.Value) ([]mapKey, error) {
+	r := make([]mapKey, 0, m.Len())
+
+	iter := m.MapRange()
+	for iter.Next() {
+		k := iter.Key()
+		for k.Kind() == reflect.Interface && !k.IsNil() {
+			k = k.Elem()
+		}
+
+		if k.Kind() != reflect.String {
+			return nil, fmt.Errorf("expected string key, %s given", typeName(k))
+		}
+
+		r = append(r, mapKey{key: k.String(), value: iter.Key()})
+	}
+
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].key < r[j].key
+	})
+
+	return r, nil
+}
+
+func typeName(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+
+	return v.Type().String()
+}
+
+func appendPath(path []string, key string) []string {
+	r := make([]string, len(path), len(path)+1)
+	copy(r, path)
+
+	return append(r, key)
+}
+
+func pathError(path []string, err error) error {
+	if err == nil || len(path) == 0 {
+		return err
+	}
+
+	segments := make(Path, len(path))
+	for i, name := range path {
+		segments[i] = PathSegment{Kind: StructField, Field: name}
+	}
+
+	return newNestedError(joinPath(path), err, segments...)
+}
+
+func joinPath(path []string) string {
+	return strings.Join(path, ".")
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"reflect"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	config struct {
+		Name     string
+		Database *databaseConfig `reflectpro:"database"`
+		Tags     []string        `reflectpro:"tags"`
+	}
+
+	databaseConfig struct {
+		Host string `reflectpro:"host"`
+		Pool poolConfig
+		port int
+	}
+
+	poolConfig struct {
+		Max uint `reflectpro:"max"`
+	}
+)
+
+func TestValueOf_mapToStruct(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		from := map[string]any{
+			"Name": "app",
+			"database": map[string]any{
+				"host": "localhost",
+				"port": "5432",
+				"Pool": map[any]any{
+					"max": "10",
+				},
+			},
+			"tags":    []any{"a", "b"},
+			"unknown": true,
+		}
+
+		v, err := intReflect.ValueOf(from, reflect.TypeOf(config{}), true)
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			config{
+				Name: "app",
+				Database: &databaseConfig{
+					Host: "localhost",
+					Pool: poolConfig{Max: 10},
+					port: 5432,
+				},
+				Tags: []string{"a", "b"},
+			},
+			v.Interface(),
+		)
+	})
+	t.Run("map[string]string", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := intReflect.ValueOf(map[string]string{"host": "localhost"}, reflect.TypeOf(databaseConfig{}), true)
+		require.NoError(t, err)
+		assert.Equal(t, databaseConfig{Host: "localhost"}, v.Interface())
+	})
+	t.Run("Promoted fields", func(t *testing.T) {
+		t.Parallel()
+
+		type (
+			base struct {
+				ID   int
+				Name string
+			}
+			entity struct {
+				base
+				Kind string
+			}
+			entityPtr struct {
+				*base
+				Kind string
+			}
+		)
+
+		from := map[string]any{"ID": "5", "Name": "a", "Kind": "user"}
+
+		v, err := intReflect.ValueOf(from, reflect.TypeOf(entity{}), true)
+		require.NoError(t, err)
+		assert.Equal(t, entity{base: base{ID: 5, Name: "a"}, Kind: "user"}, v.Interface())
+
+		v, err = intReflect.ValueOf(from, reflect.TypeOf(entityPtr{}), true)
+		require.NoError(t, err)
+		assert.Equal(t, entityPtr{base: &base{ID: 5, Name: "a"}, Kind: "user"}, v.Interface())
+
+		v, err = intReflect.ValueOf(map[string]any{"Kind": "user"}, reflect.TypeOf(entityPtr{}), true)
+		require.NoError(t, err)
+		assert.Equal(t, entityPtr{Kind: "user"}, v.Interface(), "embedded structs are allocated when needed only")
+
+		opts := intReflect.DefaultOptions()
+		opts.CaseInsensitiveKeys = true
+
+		v, err = intReflect.ValueOfWith(map[string]any{"id": 5}, reflect.TypeOf(entity{}), opts)
+		require.NoError(t, err)
+		assert.Equal(t, entity{base: base{ID: 5}}, v.Interface())
+
+		opts = intReflect.DefaultOptions()
+		opts.UnmatchedFields = intReflect.RejectMissingFields
+
+		_, err = intReflect.ValueOfWith(map[string]any{"ID": 5}, reflect.TypeOf(entity{}), opts)
+		assert.EqualError(
+			t,
+			err,
+			`cannot convert map[string]interface {} to reflect_test.entity: `+
+				`fields without source in reflect_test.entity: "Kind", "Name"`,
+		)
+	})
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		scenarios := map[string]struct {
+			from  any
+			error string
+		}{
+			"Invalid nested value": {
+				from: map[string]any{
+					"database": map[string]any{
+						"Pool": map[string]any{
+							"max": "ten",
+						},
+					},
+				},
+				error: `cannot convert map[string]interface {} to reflect_test.config: ` +
+					`database.Pool.max: cannot convert string to uint: parsing "ten": invalid syntax`,
+			},
+			"Invalid value": {
+				from: map[string]any{
+					"tags": 5,
+				},
+				error: `cannot convert map[string]interface {} to reflect_test.config: ` +
+					`tags: cannot convert int to []string`,
+			},
+			"Non-string key": {
+				from: map[any]any{
+					5: "five",
+				},
+				error: `cannot convert map[interface {}]interface {} to reflect_test.config: expected string key, int given`,
+			},
+		}
+
+		for n, s := range scenarios {
+			s := s
+
+			t.Run(n, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := intReflect.ValueOf(s.from, reflect.TypeOf(config{}), true)
+				assert.EqualError(t, err, s.error)
+			})
+		}
+	})
+}
+
+func TestValueOf_mapToStructOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Case-insensitive keys", func(t *testing.T) {
+		t.Parallel()
+
+		opts := intReflect.Options{CaseInsensitiveKeys: true}
+
+		v, err := intReflect.ValueOfWith(
+			map[string]any{"HOST": "localhost", "pool": map[string]any{"MAX": 5}},
+			reflect.TypeOf(databaseConfig{}),
+			opts,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, databaseConfig{Host: "localhost", Pool: poolConfig{Max: 5}}, v.Interface())
+	})
+	t.Run("Case-insensitive keys (exact match first)", func(t *testing.T) {
+		t.Parallel()
+
+		opts := intReflect.Options{CaseInsensitiveKeys: true}
+
+		type dst struct {
+			Name string
+			NAME string
+		}
+
+		v, err := intReflect.ValueOfWith(map[string]any{"NAME": "b"}, reflect.TypeOf(dst{}), opts)
+		require.NoError(t, err)
+		assert.Equal(t, dst{NAME: "b"}, v.Interface())
+
+		_, err = intReflect.ValueOfWith(map[string]any{"name": "a"}, reflect.TypeOf(dst{}), opts)
+		assert.EqualError(
+			t,
+			err,
+			`cannot convert map[string]interface {} to reflect_test.dst: key "name" matches many fields: "Name", "NAME"`,
+		)
+	})
+	t.Run("Case-insensitive keys (collision)", func(t *testing.T) {
+		t.Parallel()
+
+		opts := intReflect.Options{CaseInsensitiveKeys: true}
+
+		_, err := intReflect.ValueOfWith(map[string]any{"HOST": "a", "host": "b"}, reflect.TypeOf(databaseConfig{}), opts)
+		assert.EqualError(
+			t,
+			err,
+			`cannot convert map[string]interface {} to reflect_test.databaseConfig: `+
+				`keys "HOST" and "host" match the same field "Host"`,
+		)
+	})
+	t.Run("Tag name", func(t *testing.T) {
+		t.Parallel()
+
+		opts := intReflect.Options{TagName: "json"}
+
+		type dst struct {
+			Host string `json:"host" reflectpro:"server"`
+		}
+
+		v, err := intReflect.ValueOfWith(map[string]any{"host": "localhost"}, reflect.TypeOf(dst{}), opts)
+		require.NoError(t, err)
+		assert.Equal(t, dst{Host: "localhost"}, v.Interface())
+	})
+	t.Run("Reject unmatched fields", func(t *testing.T) {
+		t.Parallel()
+
+		opts := intReflect.Options{UnmatchedFields: intReflect.RejectUnmatchedFields}
+
+		_, err := intReflect.ValueOfWith(
+			map[string]any{"database": map[string]any{"host": "localhost", "user": "root"}},
+			reflect.TypeOf(config{}),
+			opts,
+		)
+		assert.EqualError(
+			t,
+			err,
+			`cannot convert map[string]interface {} to reflect_test.config: `+
+				`database: fields without source in reflect_test.databaseConfig: "Pool", "port"; `+
+				`keys without target in reflect_test.databaseConfig: "user"`,
+		)
+	})
+}
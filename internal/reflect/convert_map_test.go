@@ -0,0 +1,136 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"reflect"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueOf_mapKeyCollisions(t *testing.T) {
+	t.Parallel()
+
+	from := map[any]any{
+		int64(1): "b",
+		"2":      "c",
+		int8(1):  "a",
+		uint(1):  "d",
+		3:        "e",
+	}
+
+	scenarios := map[string]struct {
+		policy intReflect.KeyCollisions
+		output map[int]string
+		error  string
+	}{
+		"Reject": {
+			policy: intReflect.RejectKeyCollisions,
+			error: "cannot convert map[interface {}]interface {} to map[int]string: " +
+				"map key: keys int64(1), int8(1), uint(1) collide as int(1)",
+		},
+		"First key wins": {
+			policy: intReflect.FirstKeyWins,
+			output: map[int]string{1: "b", 2: "c", 3: "e"},
+		},
+		"Last key wins": {
+			policy: intReflect.LastKeyWins,
+			output: map[int]string{1: "d", 2: "c", 3: "e"},
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			opts := intReflect.Options{KeyCollisions: s.policy}
+
+			// the result must not depend on the order of iteration
+			for i := 0; i < 10; i++ {
+				v, err := intReflect.ValueOfWith(from, reflect.TypeOf(map[int]string{}), opts)
+				if s.error != "" {
+					assert.EqualError(t, err, s.error)
+
+					continue
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, s.output, v.Interface())
+			}
+		})
+	}
+}
+
+func TestValueOf_mapKeyCollisionsCaseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	from := map[string]any{
+		"host": "b",
+		"HOST": "a",
+		"Pool": map[string]any{"max": 1},
+		"pool": map[string]any{},
+	}
+
+	scenarios := map[string]struct {
+		policy intReflect.KeyCollisions
+		output databaseConfig
+		error  string
+	}{
+		"Reject": {
+			policy: intReflect.RejectKeyCollisions,
+			error: "cannot convert map[string]interface {} to reflect_test.databaseConfig: " +
+				`keys "HOST" and "host" match the same field "Host"`,
+		},
+		"First key wins": {
+			policy: intReflect.FirstKeyWins,
+			output: databaseConfig{Host: "a", Pool: poolConfig{Max: 1}},
+		},
+		"Last key wins": {
+			policy: intReflect.LastKeyWins,
+			output: databaseConfig{Host: "b"},
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			opts := intReflect.Options{CaseInsensitiveKeys: true, KeyCollisions: s.policy}
+
+			v, err := intReflect.ValueOfWith(from, reflect.TypeOf(databaseConfig{}), opts)
+			if s.error != "" {
+				assert.EqualError(t, err, s.error)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.output, v.Interface())
+		})
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+const (
+	float32MantissaBits = 24
+	float64MantissaBits = 53
+)
+
+func isInt(k reflect.Kind) bool {
+	switch k { //nolint:exhaustive
+	case
+		reflect.Int,
+		reflect.Int8,
+		reflect.Int16,
+		reflect.Int32,
+		reflect.Int64:
+		return true
+	}
+
+	return false
+}
+
+func isUint(k reflect.Kind) bool {
+	switch k { //nolint:exhaustive
+	case
+		reflect.Uint,
+		reflect.Uint8,
+		reflect.Uint16,
+		reflect.Uint32,
+		reflect.Uint64,
+		reflect.Uintptr:
+		return true
+	}
+
+	return false
+}
+
+func isFloat(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+func isNumber(k reflect.Kind) bool {
+	return isInt(k) || isUint(k) || isFloat(k)
+}
+
+// isExactNumberConversion returns true if all values of the type `from` can be represented exactly by the type `to`.
+func isExactNumberConversion(from, to reflect.Type) bool {
+	f, t := from.Kind(), to.Kind()
+
+	switch {
+	case isInt(f) && isInt(t), isUint(f) && isUint(t), isFloat(f) && isFloat(t):
+		return to.Bits() >= from.Bits()
+	case isUint(f) && isInt(t):
+		return to.Bits() > from.Bits()
+	case (isInt(f) || isUint(f)) && t == reflect.Float32:
+		return from.Bits() <= float32MantissaBits
+	case (isInt(f) || isUint(f)) && t == reflect.Float64:
+		return from.Bits() <= float64MantissaBits
+	}
+
+	return false
+}
+
+/*
+convertNumberStrictly converts a number to another numeric type.
+Unlike [reflect.Value.Convert], it returns an error whenever the result would not be equal to the given value:
+
+  - integer overflows, e.g. int64(300) to uint8,
+  - sign losses, e.g. int(-1) to uint,
+  - truncations of floats, e.g. float64(3.9) to int,
+  - NaN and infinities to integers,
+  - integers that cannot be represented exactly by floats, e.g. int64(1<<53 + 1) to float64,
+  - floats out of the range of float32, or that cannot be represented exactly by float32, e.g. float64(0.1).
+
+Both kinds MUST BE numeric, see [isNumber].
+*/
+func convertNumberStrictly(from reflect.Value, to reflect.Type) (reflect.Value, error) { //nolint:cyclop
+	result := reflect.New(to).Elem()
+
+	switch {
+	case isInt(from.Kind()):
+		if err := checkInt(from.Int(), result); err != nil {
+			return reflect.Value{}, err
+		}
+
+	case isUint(from.Kind()):
+		if err := checkUint(from.Uint(), result); err != nil {
+			return reflect.Value{}, err
+		}
+
+	default:
+		if err := checkFloat(from.Float(), result); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
+	return from.Convert(to), nil
+}
+
+func checkInt(v int64, to reflect.Value) error {
+	switch {
+	case isInt(to.Kind()):
+		if to.OverflowInt(v) {
+			return overflowError(v, to.Type())
+		}
+
+	case isUint(to.Kind()):
+		if v < 0 {
+			return fmt.Errorf("negative value %d cannot be converted to %s", v, to.Type().String())
+		}
+
+		if to.OverflowUint(uint64(v)) {
+			return overflowError(v, to.Type())
+		}
+
+	default:
+		u := uint64(v)
+		if v < 0 {
+			u = -u
+		}
+
+		if !exactFloat(u, to.Kind()) {
+			return precisionLossError(v, to.Type())
+		}
+	}
+
+	return nil
+}
+
+func checkUint(v uint64, to reflect.Value) error {
+	switch {
+	case isInt(to.Kind()):
+		if v > math.MaxInt64 || to.OverflowInt(int64(v)) {
+			return overflowError(v, to.Type())
+		}
+
+	case isUint(to.Kind()):
+		if to.OverflowUint(v) {
+			return overflowError(v, to.Type())
+		}
+
+	default:
+		if !exactFloat(v, to.Kind()) {
+			return precisionLossError(v, to.Type())
+		}
+	}
+
+	return nil
+}
+
+func checkFloat(v float64, to reflect.Value) error {
+	if isFloat(to.Kind()) {
+		if to.OverflowFloat(v) {
+			return overflowError(v, to.Type())
+		}
+
+		if to.Kind() == reflect.Float32 && float64(float32(v)) != v && !math.IsNaN(v) {
+			return precisionLossError(v, to.Type())
+		}
+
+		return nil
+	}
+
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("value %v cannot be converted to %s", v, to.Type().String())
+	}
+
+	if v != math.Trunc(v) {
+		return fmt.Errorf("value %v cannot be converted to %s without truncation", v, to.Type().String())
+	}
+
+	if isUint(to.Kind()) {
+		if v < 0 {
+			return fmt.Errorf("negative value %v cannot be converted to %s", v, to.Type().String())
+		}
+
+		if v >= math.Exp2(64) || to.OverflowUint(uint64(v)) { //nolint:gomnd
+			return overflowError(v, to.Type())
+		}
+
+		return nil
+	}
+
+	if v < math.MinInt64 || v >= math.Exp2(63) || to.OverflowInt(int64(v)) { //nolint:gomnd
+		return overflowError(v, to.Type())
+	}
+
+	return nil
+}
+
+// exactFloat returns true whenever the given absolute value of an integer can be represented exactly by a float.
+func exactFloat(abs uint64, k reflect.Kind) bool {
+	if abs == 0 {
+		return true
+	}
+
+	mantissa := float64MantissaBits
+	if k == reflect.Float32 {
+		mantissa = float32MantissaBits
+	}
+
+	return bits.Len64(abs)-bits.TrailingZeros64(abs) <= mantissa
+}
+
+func overflowError(v any, to reflect.Type) error {
+	return fmt.Errorf("value %v overflows %s", v, to.String())
+}
+
+func precisionLossError(v any, to reflect.Type) error {
+	return fmt.Errorf("value %v cannot be represented exactly by %s", v, to.String())
+}
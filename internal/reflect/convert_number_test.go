@@ -0,0 +1,167 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueOf_strict(t *testing.T) {
+	t.Parallel()
+
+	opts := intReflect.Options{Strict: true}
+
+	scenarios := map[string]struct {
+		input  any
+		output any
+		error  string
+	}{
+		`int64 to uint8`: {
+			input:  int64(255),
+			output: uint8(255),
+		},
+		`int64 to uint8 (overflow)`: {
+			input:  int64(300),
+			output: uint8(0),
+			error:  "cannot convert int64 to uint8: value 300 overflows uint8",
+		},
+		`int to int8 (overflow)`: {
+			input:  -129,
+			output: int8(0),
+			error:  "cannot convert int to int8: value -129 overflows int8",
+		},
+		`int to uint (sign loss)`: {
+			input:  -1,
+			output: uint(0),
+			error:  "cannot convert int to uint: negative value -1 cannot be converted to uint",
+		},
+		`uint64 to int64 (overflow)`: {
+			input:  uint64(math.MaxUint64),
+			output: int64(0),
+			error:  "cannot convert uint64 to int64: value 18446744073709551615 overflows int64",
+		},
+		`uint16 to uint8 (overflow)`: {
+			input:  uint16(256),
+			output: uint8(0),
+			error:  "cannot convert uint16 to uint8: value 256 overflows uint8",
+		},
+		`float64 to int`: {
+			input:  float64(3),
+			output: 3,
+		},
+		`float64 to int (truncation)`: {
+			input:  3.9,
+			output: 0,
+			error:  "cannot convert float64 to int: value 3.9 cannot be converted to int without truncation",
+		},
+		`float64 to int (NaN)`: {
+			input:  math.NaN(),
+			output: 0,
+			error:  "cannot convert float64 to int: value NaN cannot be converted to int",
+		},
+		`float32 to int64 (+Inf)`: {
+			input:  float32(math.Inf(1)),
+			output: int64(0),
+			error:  "cannot convert float32 to int64: value +Inf cannot be converted to int64",
+		},
+		`float64 to int64 (overflow)`: {
+			input:  float64(1 << 63),
+			output: int64(0),
+			error:  "cannot convert float64 to int64: value 9.223372036854776e+18 overflows int64",
+		},
+		`float64 to uint8 (overflow)`: {
+			input:  float64(256),
+			output: uint8(0),
+			error:  "cannot convert float64 to uint8: value 256 overflows uint8",
+		},
+		`float64 to uint (sign loss)`: {
+			input:  float64(-2),
+			output: uint(0),
+			error:  "cannot convert float64 to uint: negative value -2 cannot be converted to uint",
+		},
+		`float64 to float32`: {
+			input:  0.5,
+			output: float32(0.5),
+		},
+		`float64 to float32 (overflow)`: {
+			input:  math.MaxFloat64,
+			output: float32(0),
+			error:  "cannot convert float64 to float32: value 1.7976931348623157e+308 overflows float32",
+		},
+		`float64 to float32 (precision loss)`: {
+			input:  0.1,
+			output: float32(0),
+			error:  "cannot convert float64 to float32: value 0.1 cannot be represented exactly by float32",
+		},
+		`float64 to float32 (exact)`: {
+			input:  1e10,
+			output: float32(1e10),
+		},
+		`int64 to float64`: {
+			input:  int64(-1 << 53),
+			output: float64(-1 << 53),
+		},
+		`int64 to float64 (precision loss)`: {
+			input:  int64(1<<53 + 1),
+			output: float64(0),
+			error:  "cannot convert int64 to float64: value 9007199254740993 cannot be represented exactly by float64",
+		},
+		`uint32 to float32 (precision loss)`: {
+			input:  uint32(1<<24 + 1),
+			output: float32(0),
+			error:  "cannot convert uint32 to float32: value 16777217 cannot be represented exactly by float32",
+		},
+		`[]int to []int8 (overflow)`: {
+			input:  []int{1, 2, 256},
+			output: []int8{},
+			error:  "cannot convert []int to []int8: #2: cannot convert int to int8: value 256 overflows int8",
+		},
+		`map[string]any to map[string]uint (sign loss)`: {
+			input:  map[string]any{"a": -5},
+			output: map[string]uint{},
+			error: "cannot convert map[string]interface {} to map[string]uint: " +
+				"map value: cannot convert int to uint: negative value -5 cannot be converted to uint",
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := intReflect.ValueOfWith(s.input, reflect.TypeOf(s.output), opts)
+			if s.error != "" {
+				assert.EqualError(t, err, s.error)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.output, v.Interface())
+		})
+	}
+}
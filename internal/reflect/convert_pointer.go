@@ -0,0 +1,134 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+/*
+convertPointer adapts the level of indirection of the given value to the target type.
+It takes the address of a copy of the value when the target type has more levels of indirection,
+and dereferences the value when it has fewer, e.g.:
+
+	Config   => *Config
+	*Config  => Config
+	**Config => Config
+	string   => *int (the string is parsed, see [convertString])
+	*int     => *int64 (the pointed value is converted into a new pointer)
+
+Nil pointers cannot be dereferenced, but they are converted to nil pointers of the same level of indirection.
+*/
+func convertPointer(from reflect.Value, to reflect.Type, c *conversion) (_ reflect.Value, supports bool, _ error) {
+	fromDepth, toDepth := ptrDepth(from.Type()), ptrDepth(to)
+
+	switch {
+	case fromDepth > toDepth:
+		if from.IsNil() {
+			return reflect.Value{}, true, errors.New("nil pointer cannot be dereferenced")
+		}
+
+		if _, found, err := c.cycle(from, to); found {
+			return reflect.Value{}, true, err
+		}
+
+		defer c.leave(c.enter(from, to, reflect.Value{}))
+
+		v, err := c.convert(from.Elem().Interface(), to)
+		if err != nil {
+			return reflect.Value{}, true, err //nolint:wrapcheck
+		}
+
+		return v, true, nil
+
+	case fromDepth == toDepth && fromDepth > 0:
+		if from.IsNil() {
+			return reflect.Zero(to), true, nil
+		}
+
+		if v, found, err := c.cycle(from, to); found {
+			return v, true, err
+		}
+
+		// values that refer to the pointer refer to the result, see [Options.Cycles]
+		result := reflect.New(to.Elem())
+		defer c.leave(c.enter(from, to, result))
+
+		v, err := c.convert(from.Elem().Interface(), to.Elem())
+		if err != nil {
+			return reflect.Value{}, true, err //nolint:wrapcheck
+		}
+
+		result.Elem().Set(v)
+
+		return result, true, nil
+
+	case fromDepth < toDepth:
+		v, err := c.convert(from.Interface(), to.Elem())
+		if err != nil {
+			return reflect.Value{}, true, err //nolint:wrapcheck
+		}
+
+		result := reflect.New(to.Elem())
+		result.Elem().Set(v)
+
+		return result, true, nil
+	}
+
+	return reflect.Value{}, false, nil
+}
+
+func checkPointer(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	fromDepth, toDepth := ptrDepth(from), ptrDepth(to)
+
+	switch {
+	case fromDepth > toDepth:
+		v, reason := c.check(from.Elem(), to)
+		v, reason = worse(Maybe, fmt.Sprintf("nil %s cannot be dereferenced", from.String()), v, reason)
+
+		return v, true, reason
+
+	case fromDepth == toDepth && fromDepth > 0:
+		v, reason := c.check(from.Elem(), to.Elem())
+
+		return v, true, reason
+
+	case fromDepth < toDepth:
+		v, reason := c.check(from, to.Elem())
+
+		return v, true, reason
+	}
+
+	return No, false, ""
+}
+
+func ptrDepth(t reflect.Type) int {
+	r := 0
+
+	for t.Kind() == reflect.Ptr {
+		r++
+		t = t.Elem()
+	}
+
+	return r
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"reflect"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	pointerConfig struct {
+		Name string
+	}
+
+	pointerNode struct {
+		Value int
+		Next  *pointerNode
+	}
+
+	pointerNodeDTO struct {
+		Value string
+		Next  *pointerNodeDTO
+	}
+)
+
+func TestValueOf_pointers(t *testing.T) {
+	t.Parallel()
+
+	cfg := pointerConfig{Name: "app"}
+	cfgPtr := &cfg
+	var nilCfg *pointerConfig
+
+	scenarios := map[string]struct {
+		input  any
+		output any
+		error  string
+	}{
+		"T to *T": {
+			input:  cfg,
+			output: &pointerConfig{Name: "app"},
+		},
+		"*T to T": {
+			input:  &cfg,
+			output: cfg,
+		},
+		"T to **T": {
+			input:  cfg,
+			output: &cfgPtr,
+		},
+		"**T to T": {
+			input:  &cfgPtr,
+			output: cfg,
+		},
+		"string to *int": {
+			input:  "5",
+			output: func() *int { i := 5; return &i }(), //nolint:nlreturn
+		},
+		"*int to string": {
+			input:  func() *int { i := 5; return &i }(), //nolint:nlreturn
+			output: "5",
+		},
+		"[]T to []*T": {
+			input:  []pointerConfig{cfg},
+			output: []*pointerConfig{{Name: "app"}},
+		},
+		"[]*T to []T": {
+			input:  []*pointerConfig{&cfg},
+			output: []pointerConfig{cfg},
+		},
+		"empty []*T to []T": {
+			input:  []*pointerConfig{},
+			output: []pointerConfig{},
+		},
+		"map[string]*T to map[string]T": {
+			input:  map[string]*pointerConfig{"a": &cfg},
+			output: map[string]pointerConfig{"a": cfg},
+		},
+		"empty map[string]T to map[string]*T": {
+			input:  map[string]pointerConfig{},
+			output: map[string]*pointerConfig{},
+		},
+		"nil *T to T": {
+			input:  nilCfg,
+			output: pointerConfig{},
+			error: "cannot convert *reflect_test.pointerConfig to reflect_test.pointerConfig: " +
+				"nil pointer cannot be dereferenced",
+		},
+		"[]*T with nil to []T": {
+			input:  []*pointerConfig{&cfg, nil},
+			output: []pointerConfig{},
+			error: "cannot convert []*reflect_test.pointerConfig to []reflect_test.pointerConfig: " +
+				"#1: cannot convert *reflect_test.pointerConfig to reflect_test.pointerConfig: " +
+				"nil pointer cannot be dereferenced",
+		},
+		"nil *T to *T": {
+			input:  nilCfg,
+			output: nilCfg,
+		},
+		"*int to *int64": {
+			input:  func() *int { i := 5; return &i }(),          //nolint:nlreturn
+			output: func() *int64 { i := int64(5); return &i }(), //nolint:nlreturn
+		},
+		"nil *int to *int64": {
+			input:  (*int)(nil),
+			output: (*int64)(nil),
+		},
+		"**string to **int": {
+			input:  func() **string { s := "5"; p := &s; return &p }(), //nolint:nlreturn
+			output: func() **int { i := 5; p := &i; return &p }(),      //nolint:nlreturn
+		},
+		"struct with pointer fields": {
+			input:  pointerNode{Value: 1, Next: &pointerNode{Value: 2}},
+			output: pointerNodeDTO{Value: "1", Next: &pointerNodeDTO{Value: "2"}},
+		},
+		"*struct with pointer fields": {
+			input:  &pointerNodeDTO{Value: "1", Next: &pointerNodeDTO{Value: "2"}},
+			output: &pointerNode{Value: 1, Next: &pointerNode{Value: 2}},
+		},
+		"[]*struct with pointer fields": {
+			input:  []*pointerNode{{Value: 1, Next: &pointerNode{Value: 2}}, nil},
+			output: []*pointerNodeDTO{{Value: "1", Next: &pointerNodeDTO{Value: "2"}}, nil},
+		},
+		"map[string]*struct with pointer fields": {
+			input:  map[string]*pointerNode{"a": {Value: 1}, "b": nil},
+			output: map[string]*pointerNodeDTO{"a": {Value: "1"}, "b": nil},
+		},
+		"struct with pointer fields (invalid)": {
+			input:  pointerNodeDTO{Value: "1", Next: &pointerNodeDTO{Value: "two"}},
+			output: pointerNode{},
+			error: "cannot convert reflect_test.pointerNodeDTO to reflect_test.pointerNode: " +
+				`field "Next": cannot convert *reflect_test.pointerNodeDTO to *reflect_test.pointerNode: ` +
+				`cannot convert reflect_test.pointerNodeDTO to reflect_test.pointerNode: ` +
+				`field "Value": cannot convert string to int: parsing "two": invalid syntax`,
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := intReflect.ValueOf(s.input, reflect.TypeOf(s.output), true)
+			if s.error != "" {
+				assert.EqualError(t, err, s.error)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.output, v.Interface())
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+/*
+convertString converts numbers and booleans to strings and vice versa using [strconv]:
+
+	42     <-> "42"
+	3.14   <-> "3.14"
+	1e6    <-  "1e6"
+	true   <-> "true"
+	true   <-  "1", "yes", "on"
+	false  <-  "0", "no", "off"
+
+Unless [Options.RuneConversion] is enabled, integers are not converted to runes.
+*/
+func convertString(from reflect.Value, to reflect.Type, c *conversion) (_ reflect.Value, supports bool, _ error) {
+	switch {
+	case from.Kind() == reflect.String && isParsable(to.Kind()):
+		v, err := parseString(from.String(), to)
+
+		return v, true, err
+
+	case to.Kind() == reflect.String && isFormattable(from.Kind()):
+		if c.opts.RuneConversion && (isInt(from.Kind()) || isUint(from.Kind())) {
+			return reflect.Value{}, false, nil
+		}
+
+		return reflect.ValueOf(formatString(from)).Convert(to), true, nil
+	}
+
+	return reflect.Value{}, false, nil
+}
+
+func checkString(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	switch {
+	case from.Kind() == reflect.String && isParsable(to.Kind()):
+		return Maybe, true, fmt.Sprintf("parsing %s depends on the content of the string", to.String())
+
+	case to.Kind() == reflect.String && isFormattable(from.Kind()):
+		if c.opts.RuneConversion && (isInt(from.Kind()) || isUint(from.Kind())) {
+			return No, false, ""
+		}
+
+		return Yes, true, ""
+	}
+
+	return No, false, ""
+}
+
+func isParsable(k reflect.Kind) bool {
+	return isNumber(k) || k == reflect.Bool
+}
+
+func isFormattable(k reflect.Kind) bool {
+	return isNumber(k) || k == reflect.Bool
+}
+
+func parseString(s string, to reflect.Type) (reflect.Value, error) {
+	var (
+		result = reflect.New(to).Elem()
+		err    error
+	)
+
+	switch {
+	case isInt(to.Kind()):
+		var v int64
+		if v, err = strconv.ParseInt(s, 10, to.Bits()); err == nil {
+			result.SetInt(v)
+		}
+
+	case isUint(to.Kind()):
+		var v uint64
+		if v, err = strconv.ParseUint(s, 10, to.Bits()); err == nil {
+			result.SetUint(v)
+		}
+
+	case isFloat(to.Kind()):
+		var v float64
+		if v, err = strconv.ParseFloat(s, to.Bits()); err == nil {
+			result.SetFloat(v)
+		}
+
+	default:
+		var v bool
+		if v, err = parseBool(s); err == nil {
+			result.SetBool(v)
+		}
+	}
+
+	if err != nil {
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			err = numErr.Err
+		}
+
+		return reflect.Value{}, fmt.Errorf("parsing %q: %w", s, err)
+	}
+
+	return result, nil
+}
+
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "true", "1", "yes", "on":
+		return true, nil
+	case "false", "0", "no", "off":
+		return false, nil
+	}
+
+	return false, strconv.ErrSyntax
+}
+
+func formatString(v reflect.Value) string {
+	switch {
+	case isInt(v.Kind()):
+		return strconv.FormatInt(v.Int(), 10)
+	case isUint(v.Kind()):
+		return strconv.FormatUint(v.Uint(), 10)
+	case isFloat(v.Kind()):
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	default:
+		return strconv.FormatBool(v.Bool())
+	}
+}
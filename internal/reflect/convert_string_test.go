@@ -0,0 +1,184 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type level int
+
+type name string
+
+func TestValueOf_strings(t *testing.T) {
+	t.Parallel()
+
+	scenarios := map[string]struct {
+		input  any
+		output any
+		error  string
+	}{
+		`string to int8`: {
+			input:  "-128",
+			output: int8(-128),
+		},
+		`string to int8 (out of range)`: {
+			input:  "128",
+			output: int8(0),
+			error:  `cannot convert string to int8: parsing "128": value out of range`,
+		},
+		`string to uint`: {
+			input:  "42",
+			output: uint(42),
+		},
+		`string to uint (negative)`: {
+			input:  "-42",
+			output: uint(0),
+			error:  `cannot convert string to uint: parsing "-42": invalid syntax`,
+		},
+		`string to float64 (scientific notation)`: {
+			input:  "1.5e3",
+			output: float64(1500),
+		},
+		`string to float32`: {
+			input:  "0.25",
+			output: float32(0.25),
+		},
+		`string to named int`: {
+			input:  "3",
+			output: level(3),
+		},
+		`named string to int`: {
+			input:  name("7"),
+			output: 7,
+		},
+		`string to bool (yes)`: {
+			input:  "yes",
+			output: true,
+		},
+		`string to bool (ON)`: {
+			input:  "ON",
+			output: true,
+		},
+		`string to bool (1)`: {
+			input:  "1",
+			output: true,
+		},
+		`string to bool (off)`: {
+			input:  "off",
+			output: false,
+		},
+		`string to bool (invalid)`: {
+			input:  "maybe",
+			output: false,
+			error:  `cannot convert string to bool: parsing "maybe": invalid syntax`,
+		},
+		`int to named string`: {
+			input:  65,
+			output: name("65"),
+		},
+		`uint64 to string`: {
+			input:  uint64(math.MaxUint64),
+			output: "18446744073709551615",
+		},
+		`float64 to string`: {
+			input:  0.1,
+			output: "0.1",
+		},
+		`float32 to string`: {
+			input:  float32(0.1),
+			output: "0.1",
+		},
+		`float64 to string (scientific notation)`: {
+			input:  1e21,
+			output: "1e+21",
+		},
+		`bool to string`: {
+			input:  false,
+			output: "false",
+		},
+		`[]string to []int`: {
+			input:  []string{"1", "2"},
+			output: []int{1, 2},
+		},
+		`[]string{} to []int`: {
+			input:  []string{},
+			output: []int{},
+		},
+		`[][]string{} to [][]int`: {
+			input:  [][]string{nil},
+			output: [][]int{nil},
+		},
+		`map[string]string to map[int]bool`: {
+			input:  map[string]string{"1": "true"},
+			output: map[int]bool{1: true},
+		},
+		`map[string]string{} to map[int]bool`: {
+			input:  map[string]string{},
+			output: map[int]bool{},
+		},
+		`[]int to []string`: {
+			input:  []int{1, 2},
+			output: []string{"1", "2"},
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := intReflect.ValueOf(s.input, reflect.TypeOf(s.output), true)
+			if s.error != "" {
+				assert.EqualError(t, err, s.error)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.output, v.Interface())
+		})
+	}
+}
+
+func TestValueOf_runeConversion(t *testing.T) {
+	t.Parallel()
+
+	opts := intReflect.Options{RuneConversion: true}
+
+	v, err := intReflect.ValueOfWith(65, reflect.TypeOf(""), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "A", v.Interface())
+
+	v, err = intReflect.ValueOfWith(2.5, reflect.TypeOf(""), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "2.5", v.Interface())
+
+	v, err = intReflect.ValueOfWith("65", reflect.TypeOf(0), opts)
+	require.NoError(t, err)
+	assert.Equal(t, 65, v.Interface())
+}
@@ -0,0 +1,446 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	// DefaultTagName is the name of the struct tag that overrides names of fields in conversions:
+	//
+	//	type Person struct {
+	//		Name string `reflectpro:"full_name"`
+	//		Age  int    `reflectpro:"-"` // skip this field
+	//	}
+	//
+	// See [Options.TagName].
+	DefaultTagName = "reflectpro"
+	skipTag        = "-"
+)
+
+/*
+convertStruct converts structs of different types by matching their fields by name, see [Options.TagName].
+Fields of embedded structs are promoted the same way as in Go, see [reflect.Value.FieldByName].
+Values of fields are converted recursively. Unexported fields are supported.
+Fields without counterparts are handled according to [Options.UnmatchedFields].
+*/
+func convertStruct(from reflect.Value, to reflect.Type, c *conversion) (_ reflect.Value, supports bool, _ error) {
+	if from.Kind() != reflect.Struct || to.Kind() != reflect.Struct {
+		return reflect.Value{}, false, nil
+	}
+
+	v, err := convertStructToStruct(from, to, c)
+
+	return v, true, err
+}
+
+func convertStructToStruct(from reflect.Value, to reflect.Type, c *conversion) (reflect.Value, error) {
+	fromFields, err := cachedStructFields(from.Type(), c.opts.tagName())
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	toFields, err := cachedStructFields(to, c.opts.tagName())
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	var (
+		src    = addressable(from)
+		result = reflect.New(to).Elem()
+		used   = make(map[string]bool, len(fromFields.byKey))
+	)
+
+	for _, toField := range toFields.all() {
+		fromField, ok := fromFields.lookup(toField.key)
+		if !ok {
+			continue
+		}
+
+		used[toField.key] = true
+
+		fv, ok := readField(src, fromField.path)
+		if !ok {
+			continue // nil pointer to the embedded struct
+		}
+
+		v, err := c.convert(fv.Interface(), toField.Type)
+		if err != nil {
+			return reflect.Value{}, fieldError(toField.Name, err)
+		}
+
+		writeField(result, toField.path).Set(v)
+	}
+
+	if err := unmatchedFieldsError(c.opts.UnmatchedFields, from.Type(), fromFields, to, toFields, used); err != nil {
+		return reflect.Value{}, err
+	}
+
+	return result, nil
+}
+
+func checkStruct(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	if from.Kind() != reflect.Struct || to.Kind() != reflect.Struct {
+		return No, false, ""
+	}
+
+	fromFields, err := cachedStructFields(from, c.opts.tagName())
+	if err != nil {
+		return No, true, err.Error()
+	}
+
+	toFields, err := cachedStructFields(to, c.opts.tagName())
+	if err != nil {
+		return No, true, err.Error()
+	}
+
+	var (
+		v    = Yes
+		used = make(map[string]bool, len(fromFields.byKey))
+	)
+
+	for _, toField := range toFields.all() {
+		fromField, ok := fromFields.lookup(toField.key)
+		if !ok {
+			continue
+		}
+
+		used[toField.key] = true
+
+		fv, fr := c.check(fromField.Type, toField.Type)
+		v, reason = worse(v, reason, fv, prefixReason(fmt.Sprintf("field %+q", toField.Name), fr))
+	}
+
+	if err := unmatchedFieldsError(c.opts.UnmatchedFields, from, fromFields, to, toFields, used); err != nil {
+		return No, true, err.Error()
+	}
+
+	return v, true, reason
+}
+
+func unmatchedFieldsError(
+	p UnmatchedFields,
+	from reflect.Type,
+	fromFields fieldList,
+	to reflect.Type,
+	toFields fieldList,
+	used map[string]bool,
+) error {
+	if len(used) == 0 && len(toFields.list) > 0 && p&AllowNoMatchingFields == 0 {
+		return fmt.Errorf("no fields of %s match fields of %s", from.String(), to.String())
+	}
+
+	var msgs []string
+
+	if p&RejectMissingFields != 0 {
+		if names := toFields.namesExcept(used); len(names) > 0 {
+			msgs = append(msgs, fmt.Sprintf("fields without source in %s: %s", to.String(), strings.Join(names, ", ")))
+		}
+	}
+
+	if p&RejectUnknownFields != 0 {
+		if names := fromFields.namesExcept(used); len(names) > 0 {
+			msgs = append(msgs, fmt.Sprintf("fields without target in %s: %s", from.String(), strings.Join(names, ", ")))
+		}
+	}
+
+	return joinMessages(msgs)
+}
+
+func joinMessages(msgs []string) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+type structField struct {
+	reflect.StructField
+	key   string
+	index int
+	// path is the index sequence of the field, it is longer than one for promoted fields
+	path []int
+}
+
+// embedsStruct returns true for embedded structs and pointers to them, their fields are promoted.
+func (f structField) embedsStruct() bool {
+	t := f.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return f.Anonymous && t.Kind() == reflect.Struct
+}
+
+type fieldList struct {
+	list  []structField
+	byKey map[string]structField
+	// promoted are fields of embedded structs, see [promotedFields]
+	promoted      []structField
+	promotedByKey map[string]structField
+}
+
+// all returns fields of the struct followed by promoted ones.
+func (l fieldList) all() []structField {
+	r := make([]structField, 0, len(l.list)+len(l.promoted))
+	r = append(r, l.list...)
+
+	return append(r, l.promoted...)
+}
+
+// lookup returns the field, or the promoted field, with the given key.
+func (l fieldList) lookup(key string) (structField, bool) {
+	if f, ok := l.byKey[key]; ok {
+		return f, true
+	}
+
+	f, ok := l.promotedByKey[key]
+
+	return f, ok
+}
+
+/*
+find returns the field, or the promoted field, with the given key, see [fieldList.lookup].
+If caseInsensitive equals true, and there is no field with exactly the same key,
+it looks for the only field with the key equal under Unicode case-folding.
+*/
+func (l fieldList) find(key string, caseInsensitive bool) (_ structField, found bool, _ error) {
+	if f, ok := l.lookup(key); ok || !caseInsensitive {
+		return f, ok, nil
+	}
+
+	var (
+		result structField
+		names  []string
+	)
+
+	for _, f := range l.all() {
+		if strings.EqualFold(f.key, key) {
+			result = f
+			names = append(names, fmt.Sprintf("%+q", f.Name))
+		}
+	}
+
+	if len(names) > 1 {
+		return structField{}, false, fmt.Errorf("key %+q matches many fields: %s", key, strings.Join(names, ", "))
+	}
+
+	return result, len(names) == 1, nil
+}
+
+// namesExcept returns quoted names of fields, including promoted ones, whose keys are not in `keys`.
+// Embedded structs are omitted, their promoted fields are reported instead.
+func (l fieldList) namesExcept(keys map[string]bool) []string {
+	var r []string
+
+	for _, f := range l.all() {
+		if !keys[f.key] && !f.embedsStruct() {
+			r = append(r, fmt.Sprintf("%+q", f.Name))
+		}
+	}
+
+	return r
+}
+
+// structFields returns fields of the given struct except blank ones and the ones tagged with "-".
+func structFields(t reflect.Type, tagName string) (fieldList, error) {
+	r := fieldList{
+		list:  make([]structField, 0, t.NumField()),
+		byKey: make(map[string]structField, t.NumField()),
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		key, skip := fieldKey(f, tagName)
+		if skip {
+			continue
+		}
+
+		if prev, ok := r.byKey[key]; ok {
+			return fieldList{}, fmt.Errorf(
+				"fields %+q and %+q of %s have the same name %+q",
+				prev.Name,
+				f.Name,
+				t.String(),
+				key,
+			)
+		}
+
+		sf := structField{StructField: f, key: key, index: i, path: []int{i}}
+		r.list = append(r.list, sf)
+		r.byKey[key] = sf
+	}
+
+	r.promoted, r.promotedByKey = promotedFields(r.list, tagName)
+
+	return r, nil
+}
+
+// fieldKey returns the key of the given field, see [Options.TagName].
+func fieldKey(f reflect.StructField, tagName string) (_ string, skip bool) {
+	if f.Name == "_" {
+		return "", true
+	}
+
+	if tag, ok := f.Tag.Lookup(tagName); ok {
+		tag = strings.Split(tag, ",")[0]
+		if tag == skipTag {
+			return "", true
+		}
+
+		if tag != "" {
+			return tag, false
+		}
+	}
+
+	return f.Name, false
+}
+
+/*
+promotedFields returns fields of structs embedded in the struct of the given fields.
+The same as in Go, the shallowest field takes precedence,
+and many fields with the same key at the same depth hide each other.
+*/
+func promotedFields(fields []structField, tagName string) ([]structField, map[string]structField) {
+	var (
+		list    []structField
+		byKey   = make(map[string]structField)
+		known   = make(map[string]bool, len(fields))
+		visited = make(map[reflect.Type]bool)
+		next    []structField
+	)
+
+	for _, f := range fields {
+		known[f.key] = true
+
+		if f.embedsStruct() {
+			next = append(next, f)
+		}
+	}
+
+	for len(next) > 0 {
+		var (
+			current = next
+			level   = make(map[string][]structField)
+			keys    []string
+		)
+
+		next = nil
+
+		for _, e := range current {
+			t := e.Type
+			if t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+
+			if visited[t] {
+				continue
+			}
+
+			visited[t] = true
+
+			for i := 0; i < t.NumField(); i++ {
+				key, skip := fieldKey(t.Field(i), tagName)
+				if skip {
+					continue
+				}
+
+				f := structField{
+					StructField: t.Field(i),
+					key:         key,
+					index:       -1,
+					path:        append(e.path[:len(e.path):len(e.path)], i),
+				}
+
+				if f.embedsStruct() {
+					next = append(next, f)
+				}
+
+				if known[key] {
+					continue
+				}
+
+				if _, ok := level[key]; !ok {
+					keys = append(keys, key)
+				}
+
+				level[key] = append(level[key], f)
+			}
+		}
+
+		for _, key := range keys {
+			known[key] = true
+
+			if fs := level[key]; len(fs) == 1 {
+				list = append(list, fs[0])
+				byKey[key] = fs[0]
+			}
+		}
+	}
+
+	return list, byKey
+}
+
+// readField returns the accessible field of the given addressable struct,
+// it returns false if the field belongs to the embedded struct referred to by a nil pointer.
+func readField(strct reflect.Value, path []int) (reflect.Value, bool) {
+	v := strct
+
+	for i, x := range path {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+
+			v = v.Elem()
+		}
+
+		v = accessibleField(v.Field(x))
+	}
+
+	return v, true
+}
+
+// writeField returns the accessible field of the given addressable struct,
+// nil pointers to embedded structs are allocated.
+func writeField(strct reflect.Value, path []int) reflect.Value {
+	v := strct
+
+	for i, x := range path {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+
+			v = v.Elem()
+		}
+
+		v = accessibleField(v.Field(x))
+	}
+
+	return v
+}
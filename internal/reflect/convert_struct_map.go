@@ -0,0 +1,400 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+/*
+convertStructToMap encodes structs into maps with string keys.
+Keys are the names of fields, see [Options.TagName].
+Unexported fields are encoded when [Options.EncodeUnexportedFields] equals true.
+
+When values of the map are of type any, nested structs and maps are encoded into maps of the same type,
+slices and arrays into []any, pointers and interfaces are dereferenced:
+
+	map[string]any{
+		"Name": "app",
+		"Database": map[string]any{
+			"Host": "localhost",
+		},
+		"Tags": []any{"a", "b"},
+	}
+
+Otherwise, e.g. for map[string]string, the struct is flattened, and keys of nested values are joined by dots:
+
+	map[string]string{
+		"Name":          "app",
+		"Database.Host": "localhost",
+		"Tags.0":        "a",
+		"Tags.1":        "b",
+	}
+
+Values implementing [encoding.TextMarshaler], e.g. [time.Time], are not encoded as structs.
+
+Pointers, maps and slices that refer to themselves are handled according to [Options.Cycles],
+cycles of flattened values are always rejected.
+*/
+func convertStructToMap(from reflect.Value, to reflect.Type, c *conversion) (_ reflect.Value, supports bool, _ error) {
+	if from.Kind() != reflect.Struct || to.Kind() != reflect.Map || to.Key().Kind() != reflect.String {
+		return reflect.Value{}, false, nil
+	}
+
+	e := structEncoder{mapType: to, c: c}
+	result := reflect.MakeMap(to)
+
+	var err error
+
+	if isAny(to.Elem()) {
+		err = e.encodeStruct(from, result, nil)
+	} else {
+		err = e.flatten(from, result, nil)
+	}
+
+	if err != nil {
+		return reflect.Value{}, true, err
+	}
+
+	return result, true, nil
+}
+
+func checkStructToMap(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	if from.Kind() != reflect.Struct || to.Kind() != reflect.Map || to.Key().Kind() != reflect.String {
+		return No, false, ""
+	}
+
+	e := structEncoder{mapType: to, c: c}
+	v, reason := e.check(from, make(map[reflect.Type]bool))
+
+	return v, true, reason
+}
+
+type structEncoder struct {
+	mapType reflect.Type
+	c       *conversion
+}
+
+// fields calls fn for each field of the given struct that should be encoded.
+func (e structEncoder) fields(v reflect.Value, path []string, fn func(key string, field reflect.Value) error) error {
+	fields, err := cachedStructFields(v.Type(), e.c.opts.tagName())
+	if err != nil {
+		return pathError(path, err)
+	}
+
+	v = addressable(v)
+
+	for _, f := range fields.list {
+		if f.PkgPath != "" && !e.c.opts.EncodeUnexportedFields {
+			continue
+		}
+
+		if err := fn(f.key, accessibleField(v.Field(f.index))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e structEncoder) encodeStruct(from reflect.Value, to reflect.Value, path []string) error {
+	return e.fields(from, path, func(key string, field reflect.Value) error {
+		v, err := e.encode(field, appendPath(path, key))
+		if err != nil {
+			return err
+		}
+
+		to.SetMapIndex(reflect.ValueOf(key).Convert(e.mapType.Key()), v)
+
+		return nil
+	})
+}
+
+// encode returns the given value encoded as a value of the type any.
+func (e structEncoder) encode(v reflect.Value, path []string) (reflect.Value, error) { //nolint:cyclop
+	anyType := e.mapType.Elem()
+
+	switch {
+	case v.Kind() == reflect.Interface:
+		if v.IsNil() {
+			return reflect.Zero(anyType), nil
+		}
+
+		return e.encode(v.Elem(), path)
+
+	case v.Kind() == reflect.Ptr:
+		if v.IsNil() {
+			return reflect.Zero(anyType), nil
+		}
+
+		return e.encodePointer(v, path)
+
+	case isEncodableStruct(v.Type()):
+		result := reflect.MakeMap(e.mapType)
+		if err := e.encodeStruct(v, result, path); err != nil {
+			return reflect.Value{}, err
+		}
+
+		return result.Convert(anyType), nil
+
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return reflect.Zero(anyType), nil
+		}
+
+		result := reflect.MakeSlice(reflect.SliceOf(anyType), v.Len(), v.Len())
+
+		if v.Kind() == reflect.Slice {
+			if r, found, err := e.c.cycle(v, result.Type()); found {
+				return r, pathError(path, err)
+			}
+
+			defer e.c.leave(e.c.enter(v, result.Type(), result.Convert(anyType)))
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			item, err := e.encode(v.Index(i), appendPath(path, strconv.Itoa(i)))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			result.Index(i).Set(item)
+		}
+
+		return result.Convert(anyType), nil
+
+	case v.Kind() == reflect.Map:
+		if v.IsNil() {
+			return reflect.Zero(anyType), nil
+		}
+
+		result := reflect.MakeMapWithSize(e.mapType, v.Len())
+
+		if r, found, err := e.c.cycle(v, e.mapType); found {
+			return r, pathError(path, err)
+		}
+
+		defer e.c.leave(e.c.enter(v, e.mapType, result.Convert(anyType)))
+
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := e.c.convert(iter.Key().Interface(), e.mapType.Key())
+			if err != nil {
+				return reflect.Value{}, pathError(path, err)
+			}
+
+			item, err := e.encode(iter.Value(), appendPath(path, key.String()))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			result.SetMapIndex(key, item)
+		}
+
+		return result.Convert(anyType), nil
+	}
+
+	return v.Convert(anyType), nil
+}
+
+// encodePointer encodes the value the given pointer refers to.
+// Pointers to structs refer to the same encoded map, so their cycles are preserved, see [Options.Cycles].
+func (e structEncoder) encodePointer(v reflect.Value, path []string) (reflect.Value, error) {
+	if r, found, err := e.c.cycle(v, e.mapType); found {
+		return r, pathError(path, err)
+	}
+
+	if !isEncodableStruct(v.Elem().Type()) {
+		defer e.c.leave(e.c.enter(v, e.mapType, reflect.Value{}))
+
+		return e.encode(v.Elem(), path)
+	}
+
+	result := reflect.MakeMap(e.mapType)
+	defer e.c.leave(e.c.enter(v, e.mapType, result.Convert(e.mapType.Elem())))
+
+	if err := e.encodeStruct(v.Elem(), result, path); err != nil {
+		return reflect.Value{}, err
+	}
+
+	return result.Convert(e.mapType.Elem()), nil
+}
+
+// check checks values of the given type encoded by [structEncoder.encode] or [structEncoder.flatten].
+func (e structEncoder) check(t reflect.Type, visited map[reflect.Type]bool) (_ Verdict, reason string) {
+	switch {
+	case t.Kind() == reflect.Interface:
+		return Maybe, fmt.Sprintf("the dynamic type of %s is known at runtime only", t.String())
+
+	case t.Kind() == reflect.Ptr:
+		return e.check(t.Elem(), visited)
+
+	case isEncodableStruct(t):
+		if visited[t] {
+			// values of recursive types are checked already
+			return Yes, ""
+		}
+
+		visited[t] = true
+		defer delete(visited, t)
+
+		fields, err := cachedStructFields(t, e.c.opts.tagName())
+		if err != nil {
+			return No, err.Error()
+		}
+
+		v := Yes
+
+		for _, f := range fields.list {
+			if f.PkgPath != "" && !e.c.opts.EncodeUnexportedFields {
+				continue
+			}
+
+			fv, fr := e.check(f.Type, visited)
+			v, reason = worse(v, reason, fv, prefixReason(fmt.Sprintf("field %+q", f.Name), fr))
+		}
+
+		return v, reason
+
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		v, reason := e.check(t.Elem(), visited)
+
+		return v, prefixReason("element", reason)
+
+	case t.Kind() == reflect.Map:
+		keys, keysReason := e.c.check(t.Key(), e.mapType.Key())
+		values, valuesReason := e.check(t.Elem(), visited)
+
+		return worse(keys, prefixReason("map key", keysReason), values, prefixReason("map value", valuesReason))
+	}
+
+	if isAny(e.mapType.Elem()) {
+		return Yes, ""
+	}
+
+	return e.c.check(t, e.mapType.Elem())
+}
+
+// flatten writes the given value to the map, keys of nested values are joined by dots.
+func (e structEncoder) flatten(v reflect.Value, to reflect.Value, path []string) error {
+	switch {
+	case v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr:
+		if v.IsNil() {
+			return e.set(reflect.Zero(e.mapType.Elem()), to, path)
+		}
+
+		if v.Kind() == reflect.Ptr {
+			k, err := e.enter(v)
+			if err != nil {
+				return pathError(path, err)
+			}
+
+			defer e.c.leave(k)
+		}
+
+		return e.flatten(v.Elem(), to, path)
+
+	case isEncodableStruct(v.Type()):
+		return e.fields(v, path, func(key string, field reflect.Value) error {
+			return e.flatten(field, to, appendPath(path, key))
+		})
+
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		if v.Kind() == reflect.Slice {
+			k, err := e.enter(v)
+			if err != nil {
+				return pathError(path, err)
+			}
+
+			defer e.c.leave(k)
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			if err := e.flatten(v.Index(i), to, appendPath(path, strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case v.Kind() == reflect.Map:
+		k, err := e.enter(v)
+		if err != nil {
+			return pathError(path, err)
+		}
+
+		defer e.c.leave(k)
+
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := e.c.convert(iter.Key().Interface(), e.mapType.Key())
+			if err != nil {
+				return pathError(path, err)
+			}
+
+			if err := e.flatten(iter.Value(), to, appendPath(path, key.String())); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	value, err := e.c.convert(v.Interface(), e.mapType.Elem())
+	if err != nil {
+		return pathError(path, err)
+	}
+
+	return e.set(value, to, path)
+}
+
+// enter marks the given map, slice or pointer as being flattened.
+// Flattened values cannot refer to themselves, so cycles are rejected regardless of [Options.Cycles].
+// The returned key must be passed to [conversion.leave] once the value is flattened.
+func (e structEncoder) enter(v reflect.Value) (visitKey, error) {
+	if _, found, err := e.c.cycle(v, e.mapType); found {
+		return visitKey{}, err
+	}
+
+	return e.c.enter(v, e.mapType, reflect.Value{}), nil
+}
+
+func (e structEncoder) set(v reflect.Value, to reflect.Value, path []string) error {
+	key, err := e.c.convert(joinPath(path), e.mapType.Key())
+	if err != nil {
+		return err
+	}
+
+	to.SetMapIndex(key, v)
+
+	return nil
+}
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem() //nolint:gochecknoglobals
+
+func isEncodableStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct &&
+		!t.Implements(textMarshalerType) &&
+		!reflect.PtrTo(t).Implements(textMarshalerType)
+}
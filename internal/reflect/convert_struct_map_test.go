@@ -0,0 +1,166 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	auditEntry struct {
+		User     *auditUser
+		Action   string `reflectpro:"action"`
+		Tags     []string
+		Labels   map[string]any
+		Time     time.Time
+		Note     any
+		Skipped  string `reflectpro:"-"`
+		password string
+	}
+
+	auditUser struct {
+		ID    int
+		Roles [2]string
+	}
+)
+
+func newAuditEntry() auditEntry {
+	return auditEntry{
+		User:     &auditUser{ID: 7, Roles: [2]string{"admin", "user"}},
+		Action:   "login",
+		Tags:     []string{"web"},
+		Labels:   map[string]any{"env": "prod", "owner": auditUser{ID: 1}},
+		Time:     time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		Skipped:  "skipped",
+		password: "secret",
+	}
+}
+
+func TestValueOf_structToMap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("map[string]any", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := intReflect.ValueOf(newAuditEntry(), reflect.TypeOf(map[string]any{}), true)
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			map[string]any{
+				"User": map[string]any{
+					"ID":    7,
+					"Roles": []any{"admin", "user"},
+				},
+				"action": "login",
+				"Tags":   []any{"web"},
+				"Labels": map[string]any{
+					"env": "prod",
+					"owner": map[string]any{
+						"ID":    1,
+						"Roles": []any{"", ""},
+					},
+				},
+				"Time": time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+				"Note": nil,
+			},
+			v.Interface(),
+		)
+	})
+	t.Run("map[string]string", func(t *testing.T) {
+		t.Parallel()
+
+		entry := newAuditEntry()
+		from := struct {
+			User   *auditUser
+			Action string `reflectpro:"action"`
+			Tags   []string
+			Labels map[string]any
+			Note   any
+		}{
+			User:   entry.User,
+			Action: entry.Action,
+			Tags:   entry.Tags,
+			Labels: map[string]any{"env": "prod", "version": 2},
+		}
+
+		v, err := intReflect.ValueOf(from, reflect.TypeOf(map[string]string{}), true)
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			map[string]string{
+				"User.ID":        "7",
+				"User.Roles.0":   "admin",
+				"User.Roles.1":   "user",
+				"action":         "login",
+				"Tags.0":         "web",
+				"Labels.env":     "prod",
+				"Labels.version": "2",
+				"Note":           "",
+			},
+			v.Interface(),
+		)
+	})
+	t.Run("map[string]string (TextMarshaler)", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := intReflect.ValueOf(newAuditEntry(), reflect.TypeOf(map[string]string{}), true)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-01-02T03:04:05Z", v.Interface().(map[string]string)["Time"]) //nolint:forcetypeassert
+	})
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		from := struct {
+			Config struct {
+				Values []any
+			}
+		}{}
+		from.Config.Values = []any{"a", []int{1}}
+
+		_, err := intReflect.ValueOf(from, reflect.TypeOf(map[string]int{}), true)
+		assert.EqualError(
+			t,
+			err,
+			`cannot convert struct { Config struct { Values []interface {} } } to map[string]int: `+
+				`Config.Values.0: cannot convert string to int: parsing "a": invalid syntax`,
+		)
+	})
+}
+
+func TestValueOf_structToMapUnexportedFields(t *testing.T) {
+	t.Parallel()
+
+	opts := intReflect.Options{EncodeUnexportedFields: true}
+
+	v, err := intReflect.ValueOfWith(auditUser{ID: 1}, reflect.TypeOf(map[string]any{}), opts)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"ID": 1, "Roles": []any{"", ""}}, v.Interface())
+
+	v, err = intReflect.ValueOfWith(newAuditEntry(), reflect.TypeOf(map[string]any{}), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", v.Interface().(map[string]any)["password"]) //nolint:forcetypeassert
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"reflect"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type personDTO struct {
+	ID       string
+	FullName string `reflectpro:"Name"`
+	Age      string
+	age      int
+	Tags     []any
+	Address  addressDTO
+	Secret   string `reflectpro:"-"`
+	Internal string
+}
+
+type addressDTO struct {
+	City string
+}
+
+type personModel struct {
+	Name    string
+	ID      uint64
+	age     int
+	Age     uint8
+	Tags    []string
+	Address struct {
+		City string
+	}
+	Secret string
+	_      int
+}
+
+func TestValueOf_structs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		from := personDTO{
+			ID:       "42",
+			FullName: "Jane",
+			Age:      "30",
+			age:      31,
+			Tags:     []any{"admin", "user"},
+			Address:  addressDTO{City: "Warsaw"},
+			Secret:   "secret",
+			Internal: "internal",
+		}
+		v, err := intReflect.ValueOf(from, reflect.TypeOf(personModel{}), true)
+		require.NoError(t, err)
+
+		expected := personModel{
+			Name: "Jane",
+			ID:   42,
+			age:  31,
+			Age:  30,
+			Tags: []string{"admin", "user"},
+		}
+		expected.Address.City = "Warsaw"
+		assert.Equal(t, expected, v.Interface())
+	})
+	t.Run("[]any to []struct", func(t *testing.T) {
+		t.Parallel()
+
+		type dst struct {
+			B int
+			A string
+		}
+
+		from := []any{
+			struct{ A, B string }{A: "a", B: "1"},
+			struct{ B int }{B: 2},
+		}
+		v, err := intReflect.ValueOf(from, reflect.TypeOf([]dst{}), true)
+		require.NoError(t, err)
+		assert.Equal(t, []dst{{B: 1, A: "a"}, {B: 2}}, v.Interface())
+	})
+	t.Run("Promoted fields", func(t *testing.T) {
+		t.Parallel()
+
+		type (
+			base struct {
+				ID   int
+				Name string
+			}
+			audit struct {
+				Name string
+			}
+			flat struct {
+				ID   string
+				Name string
+			}
+			embedded struct {
+				base
+			}
+			embeddedPtr struct {
+				*base
+			}
+			ambiguous struct {
+				base
+				audit
+			}
+		)
+
+		v, err := intReflect.ValueOf(embedded{base: base{ID: 1, Name: "a"}}, reflect.TypeOf(flat{}), true)
+		require.NoError(t, err)
+		assert.Equal(t, flat{ID: "1", Name: "a"}, v.Interface())
+
+		v, err = intReflect.ValueOf(flat{ID: "1", Name: "a"}, reflect.TypeOf(embeddedPtr{}), true)
+		require.NoError(t, err)
+		assert.Equal(t, embeddedPtr{base: &base{ID: 1, Name: "a"}}, v.Interface())
+
+		v, err = intReflect.ValueOf(embeddedPtr{}, reflect.TypeOf(flat{}), true)
+		require.NoError(t, err, "fields of nil embedded pointers are skipped")
+		assert.Equal(t, flat{}, v.Interface())
+
+		v, err = intReflect.ValueOf(
+			ambiguous{base: base{ID: 1, Name: "a"}, audit: audit{Name: "b"}},
+			reflect.TypeOf(flat{}),
+			true,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, flat{ID: "1"}, v.Interface(), "fields at the same depth hide each other")
+	})
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("No matching fields", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := intReflect.ValueOf(struct{}{}, reflect.TypeOf(personModel{}), true)
+			assert.EqualError(
+				t,
+				err,
+				`cannot convert struct {} to reflect_test.personModel: `+
+					`no fields of struct {} match fields of reflect_test.personModel`,
+			)
+
+			opts := intReflect.DefaultOptions()
+			opts.UnmatchedFields = intReflect.AllowNoMatchingFields
+
+			v, err := intReflect.ValueOfWith(addressDTO{City: "Warsaw"}, reflect.TypeOf(personModel{}), opts)
+			require.NoError(t, err)
+			assert.Equal(t, personModel{}, v.Interface())
+
+			verdict, reason := intReflect.CanConvert(
+				reflect.TypeOf(addressDTO{}),
+				reflect.TypeOf(personModel{}),
+				intReflect.Options{},
+			)
+			assert.Equal(t, intReflect.No, verdict)
+			assert.Equal(t, "no fields of reflect_test.addressDTO match fields of reflect_test.personModel", reason)
+		})
+		t.Run("Invalid value", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := intReflect.ValueOf(personDTO{ID: "1", Age: "thirty"}, reflect.TypeOf(personModel{}), true)
+			assert.EqualError(
+				t,
+				err,
+				`cannot convert reflect_test.personDTO to reflect_test.personModel: field "Age": `+
+					`cannot convert string to uint8: parsing "thirty": invalid syntax`,
+			)
+		})
+		t.Run("Duplicate names", func(t *testing.T) {
+			t.Parallel()
+
+			type dst struct {
+				A string
+				B string `reflectpro:"A"`
+			}
+
+			_, err := intReflect.ValueOf(struct{ A string }{}, reflect.TypeOf(dst{}), true)
+			assert.EqualError(
+				t,
+				err,
+				`cannot convert struct { A string } to reflect_test.dst: `+
+					`fields "A" and "B" of reflect_test.dst have the same name "A"`,
+			)
+		})
+	})
+}
+
+func TestValueOf_structsUnmatchedFields(t *testing.T) {
+	t.Parallel()
+
+	type (
+		src struct {
+			A     string
+			Extra int
+		}
+		dst struct {
+			A       string
+			Missing int
+		}
+	)
+
+	scenarios := map[string]struct {
+		policy intReflect.UnmatchedFields
+		error  string
+	}{
+		"Ignore": {
+			policy: intReflect.IgnoreUnmatchedFields,
+		},
+		"Reject missing fields": {
+			policy: intReflect.RejectMissingFields,
+			error:  `cannot convert reflect_test.src to reflect_test.dst: fields without source in reflect_test.dst: "Missing"`,
+		},
+		"Reject unknown fields": {
+			policy: intReflect.RejectUnknownFields,
+			error:  `cannot convert reflect_test.src to reflect_test.dst: fields without target in reflect_test.src: "Extra"`,
+		},
+		"Reject unmatched fields": {
+			policy: intReflect.RejectUnmatchedFields,
+			error: `cannot convert reflect_test.src to reflect_test.dst: ` +
+				`fields without source in reflect_test.dst: "Missing"; fields without target in reflect_test.src: "Extra"`,
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			opts := intReflect.Options{UnmatchedFields: s.policy}
+
+			v, err := intReflect.ValueOfWith(src{A: "a", Extra: 1}, reflect.TypeOf(dst{}), opts)
+			if s.error != "" {
+				assert.EqualError(t, err, s.error)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, dst{A: "a"}, v.Interface())
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+//nolint:gochecknoglobals
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	stringerType        = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+/*
+convertText converts strings and byte slices to types implementing [encoding.TextUnmarshaler],
+and types implementing [encoding.TextMarshaler] to strings, e.g.:
+
+	"10.0.0.1" <-> net.IP{10, 0, 0, 1}
+	"42"       <-> big.Int
+
+Byte slices convertible to the target type, e.g. []byte{10, 0, 0, 1} to net.IP, are raw bytes,
+see [isUnmarshalableText].
+When [Options.StringerFallback] is enabled, types implementing [fmt.Stringer] are converted to strings as well.
+*/
+func convertText(from reflect.Value, to reflect.Type, c *conversion) (_ reflect.Value, supports bool, _ error) {
+	if from.Type() == to {
+		return reflect.Value{}, false, nil
+	}
+
+	if isUnmarshalableText(from.Type(), to) {
+		v, err := unmarshalText(from, to)
+
+		return v, true, err
+	}
+
+	if to.Kind() != reflect.String {
+		return reflect.Value{}, false, nil
+	}
+
+	if m, ok := implementation(from, textMarshalerType); ok {
+		b, err := m.Interface().(encoding.TextMarshaler).MarshalText() //nolint:forcetypeassert
+		if err != nil {
+			return reflect.Value{}, true, err //nolint:wrapcheck
+		}
+
+		return reflect.ValueOf(string(b)).Convert(to), true, nil
+	}
+
+	if c.opts.StringerFallback {
+		if s, ok := implementation(from, stringerType); ok {
+			return reflect.ValueOf(s.Interface().(fmt.Stringer).String()).Convert(to), true, nil //nolint:forcetypeassert
+		}
+	}
+
+	return reflect.Value{}, false, nil
+}
+
+func checkText(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	switch {
+	case from == to:
+		return No, false, ""
+
+	case isUnmarshalableText(from, to):
+		return Maybe, true, fmt.Sprintf("%s.UnmarshalText may reject the text", to.String())
+
+	case to.Kind() != reflect.String:
+		return No, false, ""
+
+	case implementsType(from, textMarshalerType):
+		return Maybe, true, fmt.Sprintf("%s.MarshalText may return an error", from.String())
+
+	case c.opts.StringerFallback && implementsType(from, stringerType):
+		if from.Kind() == reflect.Ptr {
+			return Maybe, true, fmt.Sprintf("nil %s cannot be converted", from.String())
+		}
+
+		return Yes, true, ""
+	}
+
+	return No, false, ""
+}
+
+// isUnmarshalableText returns true when `to` implements [encoding.TextUnmarshaler],
+// and `from` is a string or a byte slice. Byte slices convertible to `to`, e.g. []byte to [net.IP],
+// are raw bytes rather than text, so they are converted by [convertBuiltIn].
+func isUnmarshalableText(from, to reflect.Type) bool {
+	isBytes := from.Kind() == reflect.Slice && from.Elem().Kind() == reflect.Uint8
+	isText := from.Kind() == reflect.String || (isBytes && !from.ConvertibleTo(to))
+
+	return isText && to.Kind() != reflect.Ptr && reflect.PtrTo(to).Implements(textUnmarshalerType)
+}
+
+func unmarshalText(from reflect.Value, to reflect.Type) (reflect.Value, error) {
+	var text []byte
+	if from.Kind() == reflect.String {
+		text = []byte(from.String())
+	} else {
+		text = from.Bytes()
+	}
+
+	result := reflect.New(to)
+	if err := result.Interface().(encoding.TextUnmarshaler).UnmarshalText(text); err != nil { //nolint:forcetypeassert
+		return reflect.Value{}, err //nolint:wrapcheck
+	}
+
+	return result.Elem(), nil
+}
+
+// implementation returns the given value, or the pointer to it,
+// depending on which one implements the given interface.
+func implementation(v reflect.Value, iface reflect.Type) (reflect.Value, bool) {
+	if v.Type().Implements(iface) {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return reflect.Value{}, false
+		}
+
+		return v, true
+	}
+
+	if v.Kind() != reflect.Ptr && reflect.PtrTo(v.Type()).Implements(iface) {
+		return addressable(v).Addr(), true
+	}
+
+	return reflect.Value{}, false
+}
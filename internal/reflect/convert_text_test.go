@@ -0,0 +1,207 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"errors"
+	"math/big"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type color uint8
+
+const (
+	red color = iota + 1
+	green
+)
+
+func (c color) MarshalText() ([]byte, error) {
+	switch c {
+	case red:
+		return []byte("red"), nil
+	case green:
+		return []byte("green"), nil
+	}
+
+	return nil, errors.New("unknown color")
+}
+
+func (c *color) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "red":
+		*c = red
+	case "green":
+		*c = green
+	default:
+		return errors.New("unknown color")
+	}
+
+	return nil
+}
+
+type weekday uint8
+
+func (w weekday) String() string {
+	return [...]string{"Sunday", "Monday"}[w]
+}
+
+func TestValueOf_text(t *testing.T) {
+	t.Parallel()
+
+	scenarios := map[string]struct {
+		input  any
+		output any
+		error  string
+	}{
+		"string to net.IP": {
+			input:  "10.0.0.1",
+			output: net.IPv4(10, 0, 0, 1),
+		},
+		"[]byte to net.IP": {
+			input:  []byte{10, 0, 0, 1},
+			output: net.IP{10, 0, 0, 1},
+		},
+		"[]byte to big.Int": {
+			input:  []byte("42"),
+			output: *big.NewInt(42),
+		},
+		"[]byte to enum": {
+			input:  []byte("red"),
+			output: red,
+		},
+		"net.IP to string": {
+			input:  net.IPv4(10, 0, 0, 1),
+			output: "10.0.0.1",
+		},
+		"string to big.Int": {
+			input: "123456789012345678901234567890",
+			output: func() big.Int {
+				i, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+				return *i
+			}(),
+		},
+		"*big.Int to string": {
+			input:  big.NewInt(42),
+			output: "42",
+		},
+		"string to time.Time": {
+			input:  "2023-01-02T03:04:05Z",
+			output: time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		"time.Time to string": {
+			input:  time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+			output: "2023-01-02T03:04:05Z",
+		},
+		"string to enum": {
+			input:  "Green",
+			output: green,
+		},
+		"enum to string": {
+			input:  red,
+			output: "red",
+		},
+		"int to enum": {
+			input:  2,
+			output: green,
+		},
+		"[]any to []enum": {
+			input:  []any{"red", 2},
+			output: []color{red, green},
+		},
+		"empty []string to []big.Int": {
+			input:  []string{},
+			output: []big.Int{},
+		},
+		"map[string]string to map[color]net.IP": {
+			input:  map[string]string{"red": "127.0.0.1"},
+			output: map[color]net.IP{red: net.IPv4(127, 0, 0, 1)},
+		},
+		"Stringer to string": {
+			input:  weekday(1),
+			output: "1",
+		},
+		"string to enum (invalid)": {
+			input:  "blue",
+			output: color(0),
+			error:  "cannot convert string to reflect_test.color: unknown color",
+		},
+		"enum to string (invalid)": {
+			input:  color(5),
+			output: "",
+			error:  "cannot convert reflect_test.color to string: unknown color",
+		},
+		"nil *big.Int to string": {
+			input:  (*big.Int)(nil),
+			output: "",
+			error:  "cannot convert *big.Int to string: nil pointer cannot be dereferenced",
+		},
+		"string to net.IP (invalid)": {
+			input:  "10.0.0",
+			output: net.IP{},
+			error:  "cannot convert string to net.IP: invalid IP address: 10.0.0",
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := intReflect.ValueOf(s.input, reflect.TypeOf(s.output), true)
+			if s.error != "" {
+				assert.EqualError(t, err, s.error)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.output, v.Interface())
+		})
+	}
+}
+
+func TestValueOf_stringerFallback(t *testing.T) {
+	t.Parallel()
+
+	opts := intReflect.Options{StringerFallback: true}
+
+	v, err := intReflect.ValueOfWith(weekday(1), reflect.TypeOf(""), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "Monday", v.Interface())
+
+	v, err = intReflect.ValueOfWith(red, reflect.TypeOf(""), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "red", v.Interface(), "TextMarshaler takes precedence")
+
+	v, err = intReflect.ValueOfWith(90*time.Second, reflect.TypeOf(""), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "1m30s", v.Interface())
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+//nolint:gochecknoglobals
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+/*
+convertTime converts strings and numbers to [time.Duration] and [time.Time]:
+
+	"1m30s"                 -> time.Duration(90 * time.Second)
+	90                      -> time.Duration(90 * time.Second), see [Options.DurationUnit]
+	"2023-01-02T03:04:05Z"  -> time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC), see [Options.TimeLayouts]
+	1672628645              -> time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+Floats, e.g. numbers decoded from JSON, must be integral: float64(90) is accepted, float64(90.5) is not.
+Unix timestamps are converted to UTC. Durations are formatted as strings only by [Options.StringerFallback].
+*/
+func convertTime(from reflect.Value, to reflect.Type, c *conversion) (_ reflect.Value, supports bool, _ error) {
+	switch {
+	case to == durationType && from.Kind() == reflect.String:
+		d, err := time.ParseDuration(from.String())
+		if err != nil {
+			return reflect.Value{}, true, err //nolint:wrapcheck
+		}
+
+		return reflect.ValueOf(d), true, nil
+
+	case to == durationType && c.opts.DurationUnit != 0 && isNumber(from.Kind()):
+		n, err := convertNumberStrictly(from, reflect.TypeOf(int64(0)))
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+
+		d := time.Duration(n.Int()) * c.opts.DurationUnit
+		if d/c.opts.DurationUnit != time.Duration(n.Int()) {
+			return reflect.Value{}, true, fmt.Errorf("value %d overflows %s", n.Int(), durationType.String())
+		}
+
+		return reflect.ValueOf(d), true, nil
+
+	case to == timeType && from.Kind() == reflect.String:
+		t, err := parseTime(from.String(), c.opts.timeLayouts())
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+
+		return reflect.ValueOf(t), true, nil
+
+	case to == timeType && isNumber(from.Kind()):
+		n, err := convertNumberStrictly(from, reflect.TypeOf(int64(0)))
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+
+		return reflect.ValueOf(time.Unix(n.Int(), 0).UTC()), true, nil
+	}
+
+	return reflect.Value{}, false, nil
+}
+
+func checkTime(from, to reflect.Type, c *conversion) (_ Verdict, supports bool, reason string) {
+	switch {
+	case to == durationType && from.Kind() == reflect.String:
+		return Maybe, true, fmt.Sprintf("parsing %s depends on the content of the string", to.String())
+
+	case to == durationType && c.opts.DurationUnit != 0 && isFloat(from.Kind()):
+		return Maybe, true, fmt.Sprintf("non-integral values of %s cannot be converted to %s", from.String(), to.String())
+
+	case to == durationType && c.opts.DurationUnit != 0 && (isInt(from.Kind()) || isUint(from.Kind())):
+		return Maybe, true, fmt.Sprintf(
+			"values of %s multiplied by %s may overflow %s",
+			from.String(),
+			c.opts.DurationUnit,
+			to.String(),
+		)
+
+	case to == timeType && from.Kind() == reflect.String:
+		return Maybe, true, fmt.Sprintf("parsing %s depends on the content of the string", to.String())
+
+	case to == timeType && isFloat(from.Kind()):
+		return Maybe, true, fmt.Sprintf("non-integral values of %s cannot be converted to %s", from.String(), to.String())
+
+	case to == timeType && (isInt(from.Kind()) || isUint(from.Kind())):
+		if isUint(from.Kind()) && from.Bits() == 64 { //nolint:gomnd
+			return Maybe, true, fmt.Sprintf("values of %s may overflow int64", from.String())
+		}
+
+		return Yes, true, ""
+	}
+
+	return No, false, ""
+}
+
+func parseTime(s string, layouts []string) (time.Time, error) {
+	if len(layouts) == 1 {
+		return time.Parse(layouts[0], s) //nolint:wrapcheck
+	}
+
+	for _, l := range layouts {
+		if t, err := time.Parse(l, s); err == nil {
+			return t, nil
+		}
+	}
+
+	quoted := make([]string, len(layouts))
+	for i, l := range layouts {
+		quoted[i] = fmt.Sprintf("%+q", l)
+	}
+
+	return time.Time{}, fmt.Errorf(
+		"parsing time %+q: it does not match any of the layouts %s",
+		s,
+		strings.Join(quoted, ", "),
+	)
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueOf_time(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// error messages of the package time differ between versions of Go, so only their prefixes are compared
+	scenarios := map[string]struct {
+		input  any
+		output any
+		error  string
+	}{
+		"string to time.Duration": {
+			input:  "1m30s",
+			output: 90 * time.Second,
+		},
+		"int to time.Duration": {
+			input:  90,
+			output: time.Duration(90),
+		},
+		"time.Duration to string": {
+			input:  90 * time.Second,
+			output: "90000000000",
+		},
+		"string to time.Time": {
+			input:  "2023-01-02T03:04:05Z",
+			output: date,
+		},
+		"int64 to time.Time": {
+			input:  date.Unix(),
+			output: date,
+		},
+		"float64 to time.Time": {
+			input:  float64(date.Unix()),
+			output: date,
+		},
+		"float64 to time.Time (non-integral)": {
+			input:  1672628645.5,
+			output: time.Time{},
+			error:  "cannot convert float64 to time.Time: value 1.6726286455e+09 cannot be converted to int64 without truncation", //nolint:lll
+		},
+		"map[string]any to map[string]time.Duration": {
+			input:  map[string]any{"timeout": "5s", "interval": "1h"},
+			output: map[string]time.Duration{"timeout": 5 * time.Second, "interval": time.Hour},
+		},
+		"[][]string to [][]time.Duration": {
+			input:  [][]string{{"1s"}, {}, {"1ms", "1us"}},
+			output: [][]time.Duration{{time.Second}, {}, {time.Millisecond, time.Microsecond}},
+		},
+		"string to time.Duration (invalid)": {
+			input:  "5 seconds",
+			output: time.Duration(0),
+			error:  `cannot convert string to time.Duration: time: unknown unit`,
+		},
+		"string to time.Time (invalid)": {
+			input:  "2023-01-02",
+			output: time.Time{},
+			error:  `cannot convert string to time.Time: parsing time "2023-01-02"`,
+		},
+	}
+
+	for n, s := range scenarios {
+		s := s
+
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := intReflect.ValueOf(s.input, reflect.TypeOf(s.output), true)
+			if s.error != "" {
+				require.Error(t, err)
+				assert.True(t, strings.HasPrefix(err.Error(), s.error), err.Error())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.output, v.Interface())
+		})
+	}
+}
+
+func TestValueOf_timeOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Duration unit", func(t *testing.T) {
+		t.Parallel()
+
+		opts := intReflect.Options{DurationUnit: time.Millisecond}
+
+		v, err := intReflect.ValueOfWith([]any{1500, uint8(2), "3s"}, reflect.TypeOf([]time.Duration{}), opts)
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{1500 * time.Millisecond, 2 * time.Millisecond, 3 * time.Second}, v.Interface())
+	})
+	t.Run("Duration unit (floats)", func(t *testing.T) {
+		t.Parallel()
+
+		opts := intReflect.DefaultOptions()
+		opts.DurationUnit = time.Second
+
+		v, err := intReflect.ValueOfWith(
+			map[string]any{"timeout": float64(90)},
+			reflect.TypeOf(map[string]time.Duration{}),
+			opts,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]time.Duration{"timeout": 90 * time.Second}, v.Interface())
+
+		_, err = intReflect.ValueOfWith(1.5, reflect.TypeOf(time.Duration(0)), opts)
+		assert.EqualError(
+			t,
+			err,
+			"cannot convert float64 to time.Duration: value 1.5 cannot be converted to int64 without truncation",
+		)
+	})
+	t.Run("Duration unit (overflow)", func(t *testing.T) {
+		t.Parallel()
+
+		opts := intReflect.Options{DurationUnit: time.Hour}
+
+		_, err := intReflect.ValueOfWith(int64(1)<<62, reflect.TypeOf(time.Duration(0)), opts)
+		assert.EqualError(t, err, "cannot convert int64 to time.Duration: value 4611686018427387904 overflows time.Duration")
+	})
+	t.Run("Time layouts", func(t *testing.T) {
+		t.Parallel()
+
+		opts := intReflect.Options{TimeLayouts: []string{"2006-01-02", time.RFC3339}}
+
+		v, err := intReflect.ValueOfWith(
+			map[string]string{"a": "2023-01-02", "b": "2023-01-02T03:04:05Z"},
+			reflect.TypeOf(map[string]time.Time{}),
+			opts,
+		)
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			map[string]time.Time{
+				"a": time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+				"b": time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			v.Interface(),
+		)
+
+		_, err = intReflect.ValueOfWith("02.01.2023", reflect.TypeOf(time.Time{}), opts)
+		assert.EqualError(
+			t,
+			err,
+			`cannot convert string to time.Time: parsing time "02.01.2023": `+
+				`it does not match any of the layouts "2006-01-02", "2006-01-02T15:04:05Z07:00"`,
+		)
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// visitKey identifies a map, a slice, or a pointer being converted to the given type.
+type visitKey struct {
+	ptr  uintptr
+	len  int
+	from reflect.Type
+	to   reflect.Type
+}
+
+func newVisitKey(from reflect.Value, to reflect.Type) visitKey {
+	k := visitKey{
+		ptr:  from.Pointer(),
+		from: from.Type(),
+		to:   to,
+	}
+
+	// slices sharing the same underlying array differ by length
+	if from.Kind() == reflect.Slice {
+		k.len = from.Len()
+	}
+
+	return k
+}
+
+/*
+cycle checks whether the given map, slice or pointer is being converted to the given type already,
+so it refers to itself. If so, it returns the value being built for it, see [Options.Cycles].
+It returns an error, if the cycle cannot be preserved, or [RejectCycles] is set.
+*/
+func (c *conversion) cycle(from reflect.Value, to reflect.Type) (_ reflect.Value, found bool, _ error) {
+	v, ok := c.visiting[newVisitKey(from, to)]
+	if !ok {
+		return reflect.Value{}, false, nil
+	}
+
+	if c.opts.Cycles == RejectCycles || !v.IsValid() {
+		return reflect.Value{}, true, fmt.Errorf("cycle detected: %s refers to itself", from.Type().String())
+	}
+
+	return v, true, nil
+}
+
+// enter marks the given map, slice or pointer as being converted to the given type.
+// The result is the value being built, cycles are preserved by referring to it,
+// the invalid value means the cycle cannot be preserved.
+// The returned key must be passed to [conversion.leave] once the conversion is done.
+func (c *conversion) enter(from reflect.Value, to reflect.Type, result reflect.Value) visitKey {
+	if c.visiting == nil {
+		c.visiting = make(map[visitKey]reflect.Value)
+	}
+
+	k := newVisitKey(from, to)
+	c.visiting[k] = result
+
+	return k
+}
+
+func (c *conversion) leave(k visitKey) {
+	delete(c.visiting, k)
+}
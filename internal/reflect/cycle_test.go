@@ -0,0 +1,260 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"reflect"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	graph map[string]graph
+	list  []list
+
+	linked struct {
+		Name string
+		Next *linked
+	}
+
+	linkedDTO struct {
+		Name []byte
+		Next *linkedDTO
+	}
+)
+
+func newCyclicLinked() *linked {
+	a := &linked{Name: "a"}
+	a.Next = &linked{Name: "b", Next: a}
+
+	return a
+}
+
+func newCyclicMap() map[string]any {
+	m := map[string]any{"a": map[string]any{}}
+	m["self"] = m
+
+	return m
+}
+
+func newCyclicSlice() []any {
+	s := make([]any, 2)
+	s[0] = s
+	s[1] = []any{}
+
+	return s
+}
+
+func TestValueOf_cycles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Map", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := intReflect.ValueOf(newCyclicMap(), reflect.TypeOf(graph{}), true)
+		require.NoError(t, err)
+
+		g := v.Interface().(graph) //nolint:forcetypeassert
+		assert.Equal(t, reflect.ValueOf(g).Pointer(), reflect.ValueOf(g["self"]).Pointer())
+		assert.Equal(t, graph{}, g["a"])
+	})
+	t.Run("Slice", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := intReflect.ValueOf(newCyclicSlice(), reflect.TypeOf(list{}), true)
+		require.NoError(t, err)
+
+		l := v.Interface().(list) //nolint:forcetypeassert
+		assert.Same(t, &l[0], &l[0][0])
+		assert.Equal(t, list{}, l[1])
+	})
+	t.Run("Map to struct", func(t *testing.T) {
+		t.Parallel()
+
+		m := map[string]any{"Name": "a"}
+		m["Next"] = m
+
+		v, err := intReflect.ValueOf(m, reflect.TypeOf(linked{}), true)
+		require.NoError(t, err)
+
+		l := v.Interface().(linked) //nolint:forcetypeassert
+		assert.Equal(t, "a", l.Name)
+		assert.Equal(t, "a", l.Next.Name)
+		assert.Same(t, l.Next, l.Next.Next)
+	})
+	t.Run("Pointer", func(t *testing.T) {
+		t.Parallel()
+
+		n := &linked{Name: "a"}
+		n.Next = n
+
+		v, err := intReflect.ValueOf(n, reflect.TypeOf(&linkedDTO{}), true)
+		require.NoError(t, err)
+
+		dto := v.Interface().(*linkedDTO) //nolint:forcetypeassert
+		assert.Equal(t, []byte("a"), dto.Name)
+		assert.Same(t, dto, dto.Next)
+	})
+	t.Run("Struct with pointers", func(t *testing.T) {
+		t.Parallel()
+
+		a := newCyclicLinked()
+
+		v, err := intReflect.ValueOf(*a, reflect.TypeOf(linkedDTO{}), true)
+		require.NoError(t, err)
+
+		dto := v.Interface().(linkedDTO) //nolint:forcetypeassert
+		assert.Equal(t, []byte("a"), dto.Name)
+		assert.Equal(t, []byte("b"), dto.Next.Name)
+		assert.Equal(t, []byte("a"), dto.Next.Next.Name)
+		assert.Same(t, dto.Next.Next, dto.Next.Next.Next.Next)
+
+		v, err = intReflect.ValueOf([]*linked{a, a.Next}, reflect.TypeOf([]*linkedDTO{}), true)
+		require.NoError(t, err)
+
+		dtos := v.Interface().([]*linkedDTO) //nolint:forcetypeassert
+		assert.Same(t, dtos[0].Next.Next, dtos[0].Next.Next.Next.Next)
+	})
+	t.Run("Struct to map", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := intReflect.ValueOf(*newCyclicLinked(), reflect.TypeOf(map[string]any{}), true)
+		require.NoError(t, err)
+
+		m := v.Interface().(map[string]any)       //nolint:forcetypeassert
+		next := m["Next"].(map[string]any)        //nolint:forcetypeassert
+		nextNext := next["Next"].(map[string]any) //nolint:forcetypeassert
+		assert.Equal(t, "a", nextNext["Name"])
+		assert.Equal(t, reflect.ValueOf(next).Pointer(), reflect.ValueOf(nextNext["Next"]).Pointer())
+
+		n := &linked{Name: "a"}
+		n.Next = n
+
+		_, err = intReflect.ValueOf(n, reflect.TypeOf(map[string]any{}), true)
+		assert.EqualError(
+			t,
+			err,
+			"cannot convert *reflect_test.linked to map[string]interface {}: "+
+				"cannot convert reflect_test.linked to map[string]interface {}: "+
+				"Next: cycle detected: *reflect_test.linked refers to itself",
+		)
+	})
+	t.Run("Cycles that cannot be preserved", func(t *testing.T) {
+		t.Parallel()
+
+		var x any
+		x = &x
+
+		_, err := intReflect.ValueOf(x, reflect.TypeOf(0), true)
+		assert.EqualError(
+			t,
+			err,
+			"cannot convert *interface {} to int: cannot convert *interface {} to int: "+
+				"cycle detected: *interface {} refers to itself",
+		)
+
+		m := map[string]any{}
+		m["Next"] = m
+
+		_, err = intReflect.ValueOf(m, reflect.TypeOf(struct{ Next any }{}), true)
+		require.NoError(t, err)
+
+		_, err = intReflect.ValueOf(*newCyclicLinked(), reflect.TypeOf(map[string]string{}), true)
+		assert.EqualError(
+			t,
+			err,
+			"cannot convert reflect_test.linked to map[string]string: "+
+				"Next.Next.Next: cycle detected: *reflect_test.linked refers to itself",
+		)
+
+		type node struct {
+			Children []node
+		}
+
+		m = map[string]any{}
+		m["Children"] = []any{m}
+
+		_, err = intReflect.ValueOf(m, reflect.TypeOf(node{}), true)
+		assert.EqualError(
+			t,
+			err,
+			"cannot convert map[string]interface {} to reflect_test.node: "+
+				"Children: cannot convert []interface {} to []reflect_test.node: "+
+				"#0: cannot convert map[string]interface {} to reflect_test.node: "+
+				"cycle detected: map[string]interface {} refers to itself",
+		)
+	})
+}
+
+func TestValueOf_rejectCycles(t *testing.T) {
+	t.Parallel()
+
+	opts := intReflect.Options{Cycles: intReflect.RejectCycles}
+
+	_, err := intReflect.ValueOfWith(newCyclicMap(), reflect.TypeOf(graph{}), opts)
+	assert.EqualError(
+		t,
+		err,
+		"cannot convert map[string]interface {} to reflect_test.graph: map value: "+
+			"cannot convert map[string]interface {} to reflect_test.graph: "+
+			"cycle detected: map[string]interface {} refers to itself",
+	)
+
+	_, err = intReflect.ValueOfWith(newCyclicSlice(), reflect.TypeOf(list{}), opts)
+	assert.EqualError(
+		t,
+		err,
+		"cannot convert []interface {} to reflect_test.list: #0: "+
+			"cannot convert []interface {} to reflect_test.list: cycle detected: []interface {} refers to itself",
+	)
+
+	n := &linked{Name: "a"}
+	n.Next = n
+
+	_, err = intReflect.ValueOfWith(n, reflect.TypeOf(&linkedDTO{}), opts)
+	assert.EqualError(
+		t,
+		err,
+		"cannot convert *reflect_test.linked to *reflect_test.linkedDTO: "+
+			"cannot convert reflect_test.linked to reflect_test.linkedDTO: field \"Next\": "+
+			"cannot convert *reflect_test.linked to *reflect_test.linkedDTO: "+
+			"cycle detected: *reflect_test.linked refers to itself",
+	)
+
+	_, err = intReflect.ValueOfWith(*newCyclicLinked(), reflect.TypeOf(linkedDTO{}), opts)
+	assert.Error(t, err)
+
+	_, err = intReflect.ValueOfWith(*newCyclicLinked(), reflect.TypeOf(map[string]any{}), opts)
+	assert.EqualError(
+		t,
+		err,
+		"cannot convert reflect_test.linked to map[string]interface {}: "+
+			"Next.Next.Next: cycle detected: *reflect_test.linked refers to itself",
+	)
+
+	// shared values that do not refer to themselves are not cycles
+	shared := map[string]any{}
+	_, err = intReflect.ValueOfWith(map[string]any{"a": shared, "b": shared}, reflect.TypeOf(graph{}), opts)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+/*
+ConversionError is returned whenever a value cannot be converted.
+Errors of nested values, e.g. elements of slices, are wrapped by errors of their containers,
+use [ConversionError.Path] and [ConversionError.Innermost] to find the offending value:
+
+	var e *ConversionError
+	if errors.As(err, &e) {
+		fmt.Println(e.Path())        // [2]
+		fmt.Println(e.Innermost().From) // string
+	}
+*/
+type ConversionError struct {
+	// From is the type of the value, nil for the untyped nil.
+	From reflect.Type
+	// To is the target type.
+	To reflect.Type
+	// Err is the cause, nil if no converter supports the given types.
+	Err error
+}
+
+func (e *ConversionError) Error() string {
+	from := "<nil>"
+	if e.From != nil {
+		from = e.From.String()
+	}
+
+	msg := fmt.Sprintf("cannot convert %s to %s", from, e.To.String())
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// Path returns the path to the offending value.
+// The path is empty if the value of the type [ConversionError.From] itself cannot be converted.
+func (e *ConversionError) Path() Path {
+	var r Path
+
+	for err := e.Err; err != nil; err = errors.Unwrap(err) {
+		if p, ok := err.(*nestedError); ok { //nolint:errorlint
+			r = append(r, p.path...)
+		}
+	}
+
+	return r
+}
+
+// Innermost returns the error of the offending value, the error itself if there are no nested errors.
+func (e *ConversionError) Innermost() *ConversionError {
+	r := e
+
+	for err := e.Err; err != nil; err = errors.Unwrap(err) {
+		if c, ok := err.(*ConversionError); ok { //nolint:errorlint
+			r = c
+		}
+	}
+
+	return r
+}
+
+// PathSegmentKind defines the kind of [PathSegment].
+type PathSegmentKind uint8
+
+const (
+	// SliceIndex is an element of a slice or an array.
+	SliceIndex PathSegmentKind = iota
+	// MapKey is a key of a map.
+	MapKey
+	// MapValue is a value of a map.
+	MapValue
+	// StructField is a field of a struct,
+	// or a key of a map decoded into a struct, or encoded from a struct.
+	StructField
+)
+
+// PathSegment is a single step of [Path].
+type PathSegment struct {
+	Kind PathSegmentKind
+	// Index is the index of the element for [SliceIndex].
+	Index int
+	// Key is the key in the source map for [MapKey] and [MapValue].
+	Key any
+	// Field is the name of the field or the key for [StructField].
+	Field string
+}
+
+func (s PathSegment) String() string {
+	switch s.Kind {
+	case SliceIndex:
+		return fmt.Sprintf("[%d]", s.Index)
+	case MapKey:
+		return fmt.Sprintf("[key %s]", formatPathKey(s.Key))
+	case MapValue:
+		return fmt.Sprintf("[%s]", formatPathKey(s.Key))
+	case StructField:
+		return "." + s.Field
+	}
+
+	return fmt.Sprintf("PathSegment(%d)", s.Kind)
+}
+
+func formatPathKey(k any) string {
+	if s, ok := k.(string); ok {
+		return fmt.Sprintf("%+q", s)
+	}
+
+	return fmt.Sprint(k)
+}
+
+// Path is the path to the offending value, e.g. `.Servers[2].Ports["http"]`.
+type Path []PathSegment
+
+func (p Path) String() string {
+	parts := make([]string, len(p))
+	for i, s := range p {
+		parts[i] = s.String()
+	}
+
+	return strings.Join(parts, "")
+}
+
+// nestedError prefixes the error of a nested value, e.g. "#2: ", and holds the structured path.
+type nestedError struct {
+	path   Path
+	prefix string
+	err    error
+}
+
+func (e *nestedError) Error() string {
+	return e.prefix + ": " + e.err.Error()
+}
+
+func (e *nestedError) Unwrap() error {
+	return e.err
+}
+
+func newNestedError(prefix string, err error, path ...PathSegment) error {
+	return &nestedError{path: path, prefix: prefix, err: err}
+}
+
+func indexError(i int, err error) error {
+	return newNestedError(fmt.Sprintf("#%d", i), err, PathSegment{Kind: SliceIndex, Index: i})
+}
+
+func mapKeyError(key reflect.Value, err error) error {
+	return newNestedError("map key", err, PathSegment{Kind: MapKey, Key: key.Interface()})
+}
+
+func mapValueError(key reflect.Value, err error) error {
+	return newNestedError("map value", err, PathSegment{Kind: MapValue, Key: key.Interface()})
+}
+
+func fieldError(name string, err error) error {
+	return newNestedError(fmt.Sprintf("field %+q", name), err, PathSegment{Kind: StructField, Field: name})
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversionError(t *testing.T) {
+	t.Parallel()
+
+	type (
+		server struct {
+			Ports map[string]int
+		}
+		config struct {
+			Servers []server
+		}
+	)
+
+	scenarios := []struct {
+		name      string
+		input     any
+		to        reflect.Type
+		path      string
+		innerFrom reflect.Type
+		innerTo   reflect.Type
+		error     string
+	}{
+		{
+			name:      "No converter",
+			input:     struct{}{},
+			to:        reflect.TypeOf(0),
+			path:      "",
+			innerFrom: reflect.TypeOf(struct{}{}),
+			innerTo:   reflect.TypeOf(0),
+			error:     "cannot convert struct {} to int",
+		},
+		{
+			name:      "Untyped nil",
+			input:     nil,
+			to:        reflect.TypeOf(0),
+			path:      "",
+			innerFrom: nil,
+			innerTo:   reflect.TypeOf(0),
+			error:     "cannot convert <nil> to int",
+		},
+		{
+			name:      "Slice",
+			input:     []any{1, 2, struct{}{}},
+			to:        reflect.TypeOf([]int{}),
+			path:      "[2]",
+			innerFrom: reflect.TypeOf(struct{}{}),
+			innerTo:   reflect.TypeOf(0),
+			error:     "cannot convert []interface {} to []int: #2: cannot convert struct {} to int",
+		},
+		{
+			name:      "Map key",
+			input:     map[string]int{"a": 1},
+			to:        reflect.TypeOf(map[int]int{}),
+			path:      `[key "a"]`,
+			innerFrom: reflect.TypeOf(""),
+			innerTo:   reflect.TypeOf(0),
+			error: `cannot convert map[string]int to map[int]int: map key: ` +
+				`cannot convert string to int: parsing "a": invalid syntax`,
+		},
+		{
+			name:      "Map value",
+			input:     map[int]any{5: []int{}},
+			to:        reflect.TypeOf(map[int]int{}),
+			path:      "[5]",
+			innerFrom: reflect.TypeOf([]int{}),
+			innerTo:   reflect.TypeOf(0),
+			error:     "cannot convert map[int]interface {} to map[int]int: map value: cannot convert []int to int",
+		},
+		{
+			name: "Nested",
+			input: map[string]any{
+				"Servers": []any{
+					map[string]any{"Ports": map[string]any{"http": 80}},
+					map[string]any{"Ports": map[string]any{"http": "eighty"}},
+				},
+			},
+			to:        reflect.TypeOf(config{}),
+			path:      `.Servers[1].Ports["http"]`,
+			innerFrom: reflect.TypeOf(""),
+			innerTo:   reflect.TypeOf(0),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := intReflect.ValueOf(s.input, s.to, true)
+			require.Error(t, err)
+
+			if s.error != "" {
+				assert.EqualError(t, err, s.error)
+			}
+
+			var convErr *intReflect.ConversionError
+			require.True(t, errors.As(err, &convErr))
+			assert.Equal(t, s.to, convErr.To)
+			assert.Equal(t, s.path, convErr.Path().String())
+
+			inner := convErr.Innermost()
+			assert.Equal(t, s.innerFrom, inner.From)
+			assert.Equal(t, s.innerTo, inner.To)
+			assert.Empty(t, inner.Path())
+		})
+	}
+}
+
+func TestConversionError_Path(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		Name int
+	}
+
+	_, err := intReflect.ValueOf([]any{map[string]any{"Name": "x"}}, reflect.TypeOf([]item{}), true)
+	require.Error(t, err)
+
+	var convErr *intReflect.ConversionError
+	require.True(t, errors.As(err, &convErr))
+	assert.Equal(
+		t,
+		intReflect.Path{
+			{Kind: intReflect.SliceIndex, Index: 0},
+			{Kind: intReflect.StructField, Field: "Name"},
+		},
+		convErr.Path(),
+	)
+}
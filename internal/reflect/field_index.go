@@ -0,0 +1,164 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/gontainer/grouperror"
+)
+
+/*
+GetByIndex works similar to [Get], but it finds the field by the index sequence, see [reflect.Value.FieldByIndex].
+It allows for reading fields that cannot be referred to by names, e.g. blank, or shadowed ones.
+*/
+func GetByIndex(strct any, index []int) (_ any, err error) { //nolint:ireturn
+	defer func() {
+		if err != nil {
+			err = grouperror.Prefix(fmt.Sprintf("get (%T) by index %v: ", strct, index), err)
+		}
+	}()
+
+	v, err := structValue(strct)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := fieldByIndex(addressable(v), index)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Interface(), nil
+}
+
+// SetByIndex works similar to [SetWith], but it finds the field by the index sequence, see [GetByIndex].
+// Nil pointers to structs along the index sequence are allocated.
+func SetByIndex(strct any, index []int, val any, opts Options) (err error) {
+	defer func() {
+		if err != nil {
+			err = grouperror.Prefix(fmt.Sprintf("set (%T) by index %v: ", strct, index), err)
+		}
+	}()
+
+	return setStruct(strct, func(v reflect.Value) error {
+		return setByIndex(v, index, val, opts)
+	})
+}
+
+// indexPrefix returns the prefix of errors of the step #i of the given index sequence, see [pathPrefix].
+func indexPrefix(index []int, i int) string {
+	if len(index) == 1 {
+		return ""
+	}
+
+	return fmt.Sprintf("%v: ", index[:i+1])
+}
+
+// fieldByIndex returns the accessible field of the given addressable struct, see [accessibleField].
+func fieldByIndex(strct reflect.Value, index []int) (reflect.Value, error) {
+	if len(index) == 0 {
+		return reflect.Value{}, errors.New("empty index")
+	}
+
+	v := strct
+
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Struct {
+			if v.IsNil() {
+				err := fmt.Errorf("unexpected nil %s", v.Type().String())
+
+				return reflect.Value{}, grouperror.Prefix(indexPrefix(index, i-1), err) //nolint:wrapcheck
+			}
+
+			v = v.Elem()
+		}
+
+		f, err := fieldAt(v, x)
+		if err != nil {
+			return reflect.Value{}, grouperror.Prefix(indexPrefix(index, i), err) //nolint:wrapcheck
+		}
+
+		v = f
+	}
+
+	return v, nil
+}
+
+// setByIndex assigns the given value to the field of the given addressable struct.
+// The struct is not changed unless the value is assigned successfully.
+func setByIndex(strct reflect.Value, index []int, val any, opts Options) error {
+	if len(index) == 0 {
+		return errors.New("empty index")
+	}
+
+	return setIndex(strct, index, 0, val, opts)
+}
+
+func setIndex(strct reflect.Value, index []int, i int, val any, opts Options) error {
+	f, err := fieldAt(strct, index[i])
+	if err != nil {
+		return grouperror.Prefix(indexPrefix(index, i), err) //nolint:wrapcheck
+	}
+
+	if i+1 == len(index) {
+		v, err := ValueOfWith(val, f.Type(), opts)
+		if err != nil {
+			return grouperror.Prefix(indexPrefix(index, i), err) //nolint:wrapcheck
+		}
+
+		f.Set(v)
+
+		return nil
+	}
+
+	if f.Kind() == reflect.Ptr && f.Type().Elem().Kind() == reflect.Struct {
+		if !f.IsNil() {
+			return setIndex(f.Elem(), index, i+1, val, opts)
+		}
+
+		tmp := reflect.New(f.Type().Elem())
+		if err := setIndex(tmp.Elem(), index, i+1, val, opts); err != nil {
+			return err
+		}
+
+		f.Set(tmp)
+
+		return nil
+	}
+
+	return setIndex(f, index, i+1, val, opts)
+}
+
+// fieldAt returns the accessible field #i of the given addressable struct.
+func fieldAt(strct reflect.Value, i int) (reflect.Value, error) {
+	if strct.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("expected struct, %s given", strct.Type().String())
+	}
+
+	if i < 0 || i >= strct.NumField() {
+		return reflect.Value{}, fmt.Errorf("field #%d does not exist in %s", i, strct.Type().String())
+	}
+
+	return accessibleField(strct.Field(i)), nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"testing"
+
+	"github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	indexInner struct {
+		Name string
+		_    int
+	}
+
+	indexOuter struct {
+		*indexInner
+		Name   string
+		_      int
+		_      string
+		secret int
+	}
+)
+
+func TestGetByIndex(t *testing.T) {
+	t.Parallel()
+
+	o := indexOuter{indexInner: &indexInner{Name: "inner"}, Name: "outer", secret: 5}
+
+	scenarios := []struct {
+		index    []int
+		expected any
+		error    string
+	}{
+		{index: []int{1}, expected: "outer"},
+		{index: []int{0, 0}, expected: "inner"},
+		{index: []int{0, 1}, expected: 0},
+		{index: []int{2}, expected: 0},
+		{index: []int{3}, expected: ""},
+		{index: []int{4}, expected: 5},
+		{index: []int{}, error: "get (reflect_test.indexOuter) by index []: empty index"},
+		{index: []int{5}, error: "get (reflect_test.indexOuter) by index [5]: field #5 does not exist in reflect_test.indexOuter"},    //nolint:lll
+		{index: []int{-1}, error: "get (reflect_test.indexOuter) by index [-1]: field #-1 does not exist in reflect_test.indexOuter"}, //nolint:lll
+		{index: []int{1, 0}, error: "get (reflect_test.indexOuter) by index [1 0]: [1 0]: expected struct, string given"},
+	}
+
+	for _, s := range scenarios {
+		s := s
+
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			v, err := reflect.GetByIndex(o, s.index)
+			if s.error != "" {
+				assert.EqualError(t, err, s.error)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.expected, v)
+		})
+	}
+
+	t.Run("Nil embedded pointer", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reflect.GetByIndex(&indexOuter{}, []int{0, 0})
+		assert.EqualError(
+			t,
+			err,
+			"get (*reflect_test.indexOuter) by index [0 0]: [0]: unexpected nil *reflect_test.indexInner",
+		)
+	})
+}
+
+func TestSetByIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		var o indexOuter
+
+		require.NoError(t, reflect.SetByIndex(&o, []int{0, 0}, "inner", reflect.DefaultOptions()))
+		require.NoError(t, reflect.SetByIndex(&o, []int{1}, "outer", reflect.DefaultOptions()))
+		require.NoError(t, reflect.SetByIndex(&o, []int{2}, "7", reflect.DefaultOptions()))
+		require.NoError(t, reflect.SetByIndex(&o, []int{3}, "padding", reflect.DefaultOptions()))
+		require.NoError(t, reflect.SetByIndex(&o, []int{4}, 5, reflect.DefaultOptions()))
+
+		assert.Equal(t, "inner", o.indexInner.Name)
+		assert.Equal(t, "outer", o.Name)
+		assert.Equal(t, 5, o.secret)
+
+		for index, expected := range map[int]any{2: 7, 3: "padding"} {
+			v, err := reflect.GetByIndex(o, []int{index})
+			require.NoError(t, err)
+			assert.Equal(t, expected, v)
+		}
+	})
+
+	t.Run("Interface", func(t *testing.T) {
+		t.Parallel()
+
+		var o any = indexOuter{}
+
+		require.NoError(t, reflect.SetByIndex(&o, []int{0, 0}, "inner", reflect.DefaultOptions()))
+		assert.Equal(t, "inner", o.(indexOuter).indexInner.Name) //nolint:forcetypeassert
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		var o indexOuter
+
+		opts := reflect.DefaultOptions()
+		opts.AssignOnly = true
+
+		err := reflect.SetByIndex(&o, []int{0, 1}, "1", opts)
+		assert.EqualError(
+			t,
+			err,
+			"set (*reflect_test.indexOuter) by index [0 1]: [0 1]: value of type string is not assignable to type int",
+		)
+		assert.Nil(t, o.indexInner, "the struct must not be changed")
+
+		err = reflect.SetByIndex(&o, []int{0, 2}, 1, opts)
+		assert.EqualError(
+			t,
+			err,
+			"set (*reflect_test.indexOuter) by index [0 2]: [0 2]: field #2 does not exist in reflect_test.indexInner",
+		)
+
+		err = reflect.SetByIndex(o, []int{1}, "outer", opts)
+		assert.EqualError(t, err, "set (reflect_test.indexOuter) by index [1]: pointer to nil struct given")
+
+		err = reflect.SetByIndex(&o, nil, "outer", opts)
+		assert.EqualError(t, err, "set (*reflect_test.indexOuter) by index []: empty index")
+	})
+}
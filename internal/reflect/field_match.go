@@ -0,0 +1,163 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+//nolint:gochecknoglobals
+var (
+	fieldNameIndexes sync.Map // map[fieldNameIndexKey]fieldNameIndex
+)
+
+type fieldNameIndexKey struct {
+	t reflect.Type
+	m FieldMatching
+}
+
+// fieldNameIndex maps keys of names, see [FieldMatching.key], to the shallowest fields with these keys.
+type fieldNameIndex map[string][]Path
+
+func (m FieldMatching) key(name string) string {
+	switch m {
+	case MatchExact:
+		return name
+	case MatchCaseInsensitive:
+		return strings.ToLower(name)
+	case MatchNormalized:
+		return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	}
+
+	return name
+}
+
+/*
+matchField replaces the name in the segment #i of the given path
+by the name of the field of the struct `t` that matches it.
+Exact matches take precedence, fields of embedded structs are promoted the same way as in Go,
+and many fields that match the name at the same depth make it ambiguous.
+*/
+func matchField(t reflect.Type, path Path, i int, m FieldMatching) (Path, error) {
+	name := path[i].Field
+
+	if m == MatchExact {
+		return path, nil
+	}
+
+	if _, ok := t.FieldByName(name); ok {
+		return path, nil
+	}
+
+	found := cachedFieldNameIndex(t, m)[m.key(name)]
+
+	switch len(found) {
+	case 0:
+		return path, nil // [fieldByName] returns the error
+	case 1:
+		// the shallowest field is the one promoted by Go, so it can be resolved by its name, see [lookupField]
+		r := make(Path, len(path))
+		copy(r, path)
+		r[i].Field = found[0][len(found[0])-1].Field
+
+		return r, nil
+	}
+
+	names := make([]string, len(found))
+	for j, p := range found {
+		names[j] = fmt.Sprintf("%+q", strings.TrimPrefix(p.String(), "."))
+	}
+
+	return nil, fmt.Errorf("field %+q matches many fields: %s", name, strings.Join(names, ", "))
+}
+
+// cachedFieldNameIndex returns [buildFieldNameIndex] cached per type and matching.
+func cachedFieldNameIndex(t reflect.Type, m FieldMatching) fieldNameIndex {
+	key := fieldNameIndexKey{t: t, m: m}
+
+	if r, ok := fieldNameIndexes.Load(key); ok {
+		return r.(fieldNameIndex) //nolint:forcetypeassert
+	}
+
+	r := buildFieldNameIndex(t, m)
+	fieldNameIndexes.Store(key, r)
+
+	return r
+}
+
+// buildFieldNameIndex indexes fields of the given struct, including unexported and promoted ones,
+// by keys of their names.
+func buildFieldNameIndex(t reflect.Type, m FieldMatching) fieldNameIndex {
+	var (
+		r       = make(fieldNameIndex)
+		next    = []embeddedStruct{{t: t}}
+		visited = make(map[reflect.Type]struct{})
+	)
+
+	for len(next) > 0 {
+		current := next
+		next = nil
+		level := make(fieldNameIndex)
+
+		for _, e := range current {
+			if _, ok := visited[e.t]; ok {
+				continue
+			}
+
+			for i := 0; i < e.t.NumField(); i++ {
+				sf := e.t.Field(i)
+				if sf.Name == "_" {
+					continue
+				}
+
+				path := append(e.path[:len(e.path):len(e.path)], PathSegment{Kind: StructField, Field: sf.Name})
+
+				if key := m.key(sf.Name); len(r[key]) == 0 {
+					level[key] = append(level[key], path)
+				}
+
+				if sf.Anonymous {
+					ft := sf.Type
+					if ft.Kind() == reflect.Ptr {
+						ft = ft.Elem()
+					}
+
+					if ft.Kind() == reflect.Struct {
+						next = append(next, embeddedStruct{t: ft, path: path})
+					}
+				}
+			}
+		}
+
+		for key, paths := range level {
+			r[key] = paths
+		}
+
+		for _, e := range current {
+			visited[e.t] = struct{}{}
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"testing"
+
+	"github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	matchLimits struct {
+		Timeout int
+	}
+
+	matchPool struct {
+		*matchLimits
+		MaxConns int
+		minConns int
+	}
+
+	matchConfig struct {
+		matchPool
+		Host      string
+		HOST      string
+		Max_Idle  int //nolint:revive,stylecheck
+		MaxIdle   int
+		DebugMode bool
+	}
+)
+
+func TestGetWith_fieldMatching(t *testing.T) {
+	t.Parallel()
+
+	cfg := matchConfig{
+		matchPool: matchPool{matchLimits: &matchLimits{Timeout: 30}, MaxConns: 10, minConns: 1},
+		Host:      "host",
+		HOST:      "HOST",
+		DebugMode: true,
+	}
+
+	scenarios := []struct {
+		name     string
+		matching reflect.FieldMatching
+		expected any
+		error    string
+	}{
+		{name: "MaxConns", matching: reflect.MatchExact, expected: 10},
+		{name: "maxconns", matching: reflect.MatchExact, error: `field "maxconns" does not exist`},
+		{name: "maxconns", matching: reflect.MatchCaseInsensitive, expected: 10},
+		{name: "MINCONNS", matching: reflect.MatchCaseInsensitive, expected: 1},
+		{name: "max_conns", matching: reflect.MatchCaseInsensitive, error: `field "max_conns" does not exist`},
+		{name: "max_conns", matching: reflect.MatchNormalized, expected: 10},
+		{name: "DEBUG-MODE", matching: reflect.MatchNormalized, expected: true},
+		{name: "timeout", matching: reflect.MatchNormalized, expected: 30},
+		{name: "HOST", matching: reflect.MatchCaseInsensitive, expected: "HOST"}, // exact match wins
+		{
+			name:     "host",
+			matching: reflect.MatchCaseInsensitive,
+			error:    `field "host" matches many fields: "Host", "HOST"`,
+		},
+		{
+			name:     "max-idle",
+			matching: reflect.MatchNormalized,
+			error:    `field "max-idle" matches many fields: "Max_Idle", "MaxIdle"`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := reflect.GetWith(cfg, s.name, reflect.Options{FieldMatching: s.matching})
+			if s.error != "" {
+				assert.EqualError(t, err, `get (reflect_test.matchConfig).`+quoted(s.name)+`: `+s.error)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.expected, v)
+		})
+	}
+}
+
+func TestSetWith_fieldMatching(t *testing.T) {
+	t.Parallel()
+
+	opts := reflect.Options{FieldMatching: reflect.MatchNormalized}
+
+	var cfg struct {
+		Database struct {
+			matchPool
+		}
+	}
+
+	require.NoError(t, reflect.SetWith(&cfg, "database.max_conns", "10", opts))
+	require.NoError(t, reflect.SetWith(&cfg, "DATABASE.TIMEOUT", 30, opts))
+	assert.Equal(t, 10, cfg.Database.MaxConns)
+	assert.Equal(t, &matchLimits{Timeout: 30}, cfg.Database.matchLimits)
+
+	err := reflect.SetWith(&cfg, "database.max_conns", "ten", opts)
+	assert.EqualError(
+		t,
+		err,
+		`set (*struct { Database struct { reflect_test.matchPool } })."database.max_conns": `+
+			`Database.MaxConns: cannot convert string to int: parsing "ten": invalid syntax`,
+	)
+}
+
+func TestGetSet_promotedFromNilPointer(t *testing.T) {
+	t.Parallel()
+
+	for _, m := range []reflect.FieldMatching{reflect.MatchExact, reflect.MatchCaseInsensitive} {
+		m := m
+		opts := reflect.Options{FieldMatching: m}
+
+		name := "Timeout"
+		if m == reflect.MatchCaseInsensitive {
+			name = "timeout"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var cfg matchConfig
+
+			_, err := reflect.GetWith(cfg, name, opts)
+			assert.EqualError(
+				t,
+				err,
+				`get (reflect_test.matchConfig).`+quoted(name)+`: field "Timeout" is promoted from nil *reflect_test.matchLimits`,
+			)
+
+			err = reflect.SetWith(&cfg, name, "thirty", opts)
+			require.Error(t, err)
+			assert.Nil(t, cfg.matchLimits, "nil pointers are not allocated unless the value is assigned")
+
+			require.NoError(t, reflect.SetWith(&cfg, name, 30, opts))
+			assert.Equal(t, &matchLimits{Timeout: 30}, cfg.matchLimits)
+		})
+	}
+}
+
+func quoted(s string) string {
+	return `"` + s + `"`
+}
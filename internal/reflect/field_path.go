@@ -0,0 +1,405 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gontainer/grouperror"
+)
+
+/*
+parseFieldPath parses paths to nested fields, e.g. `Servers[2].Host` or `Labels["env"]`.
+Fields are separated by dots, `[i]` refers to an element of a slice or an array,
+and `["key"]` refers to a value of a map. Keys are quoted the same way as in Go.
+*/
+func parseFieldPath(s string) (Path, error) {
+	var r Path
+
+	for i := 0; i < len(s); {
+		switch {
+		case s[i] == '[':
+			seg, n, err := parseBrackets(s[i:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %+q: %w", s, err)
+			}
+
+			r = append(r, seg)
+			i += n
+		default:
+			if len(r) > 0 {
+				if s[i] != '.' {
+					return nil, fmt.Errorf("invalid path %+q: unexpected %+q at position %d", s, s[i], i)
+				}
+
+				i++
+			}
+
+			n := strings.IndexAny(s[i:], ".[")
+			if n == -1 {
+				n = len(s) - i
+			}
+
+			if n == 0 {
+				return nil, fmt.Errorf("invalid path %+q: empty field name at position %d", s, i)
+			}
+
+			r = append(r, PathSegment{Kind: StructField, Field: s[i : i+n]})
+			i += n
+		}
+	}
+
+	if len(r) == 0 || r[0].Kind != StructField {
+		return nil, fmt.Errorf("invalid path %+q: expected field name at position 0", s)
+	}
+
+	return r, nil
+}
+
+// parseBrackets parses `[i]` or `["key"]` at the beginning of the given string,
+// and returns the number of parsed bytes.
+func parseBrackets(s string) (_ PathSegment, n int, _ error) {
+	if len(s) > 1 && s[1] == '"' {
+		end := 2
+		for end < len(s) && s[end] != '"' {
+			if s[end] == '\\' {
+				end++
+			}
+
+			end++
+		}
+
+		if end+1 >= len(s) || s[end+1] != ']' {
+			return PathSegment{}, 0, fmt.Errorf("invalid key %s", s)
+		}
+
+		key, err := strconv.Unquote(s[1 : end+1])
+		if err != nil {
+			return PathSegment{}, 0, fmt.Errorf("invalid key %s", s[:end+2])
+		}
+
+		return PathSegment{Kind: MapValue, Key: key}, end + 2, nil //nolint:gomnd
+	}
+
+	end := strings.IndexByte(s, ']')
+	if end == -1 {
+		return PathSegment{}, 0, fmt.Errorf("missing %+q in %s", ']', s)
+	}
+
+	i, err := strconv.Atoi(s[1:end])
+	if err != nil || i < 0 {
+		return PathSegment{}, 0, fmt.Errorf("invalid index %s", s[:end+1])
+	}
+
+	return PathSegment{Kind: SliceIndex, Index: i}, end + 1, nil
+}
+
+// pathPrefix returns the prefix of errors of the segment #i of the given path.
+// Paths of a single segment are not prefixed, errors refer to the only field then.
+func pathPrefix(path Path, i int) string {
+	if len(path) == 1 {
+		return ""
+	}
+
+	return strings.TrimPrefix(path[:i+1].String(), ".") + ": "
+}
+
+/*
+getPath returns the value of the given path.
+The first segment must be a field of the struct `strct`.
+Unexported fields are accessible, see [accessibleField].
+*/
+func getPath(strct reflect.Value, path Path, m FieldMatching) (reflect.Value, error) {
+	v := strct
+
+	for i := 0; i < len(path); i++ {
+		var err error
+
+		if i > 0 {
+			v, err = indirect(v)
+		}
+
+		if err == nil && path[i].Kind == StructField && v.Kind() == reflect.Struct {
+			var matched Path
+			if matched, err = matchField(v.Type(), path, i, m); err == nil {
+				path = matched
+			}
+		}
+
+		if err == nil {
+			v, err = pathSegment(v, path[i])
+		}
+
+		if err != nil {
+			return reflect.Value{}, grouperror.Prefix(pathPrefix(path, i), err) //nolint:wrapcheck
+		}
+	}
+
+	return v, nil
+}
+
+// indirect dereferences pointers and interfaces.
+func indirect(v reflect.Value) (reflect.Value, error) {
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if _, err := ValueToKindChain(v); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, fmt.Errorf("unexpected nil %s", v.Type().String())
+		}
+
+		v = v.Elem()
+	}
+
+	return v, nil
+}
+
+// pathSegment returns the value of the given segment of a path, the value `v` cannot be a pointer.
+func pathSegment(v reflect.Value, seg PathSegment) (reflect.Value, error) {
+	switch seg.Kind { //nolint:exhaustive
+	case StructField:
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("expected struct, %s given", v.Type().String())
+		}
+
+		if err := fieldNotSupportedError(seg.Field); err != nil {
+			return reflect.Value{}, err
+		}
+
+		return fieldByName(v, seg.Field)
+
+	case SliceIndex:
+		switch v.Kind() { //nolint:exhaustive
+		case reflect.Slice, reflect.Array:
+			if seg.Index >= v.Len() {
+				return reflect.Value{}, fmt.Errorf("index out of range [%d] with length %d", seg.Index, v.Len())
+			}
+
+			return addressable(v).Index(seg.Index), nil
+		case reflect.Map:
+			return mapValue(v, seg.Index)
+		}
+
+		return reflect.Value{}, fmt.Errorf("expected slice, array or map, %s given", v.Type().String())
+
+	default:
+		if v.Kind() != reflect.Map {
+			return reflect.Value{}, fmt.Errorf("expected map, %s given", v.Type().String())
+		}
+
+		return mapValue(v, seg.Key)
+	}
+}
+
+// mapValue returns the value of the given key, the key is converted to the type of keys of the map.
+func mapValue(m reflect.Value, key any) (reflect.Value, error) {
+	k, err := pathMapKey(m.Type(), key)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	r := m.MapIndex(k)
+	if !r.IsValid() {
+		return reflect.Value{}, fmt.Errorf("key %s does not exist", formatPathKey(key))
+	}
+
+	return r, nil
+}
+
+func pathMapKey(mapType reflect.Type, key any) (reflect.Value, error) {
+	k, err := convert(key, mapType.Key(), DefaultOptions())
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("key %s: %w", formatPathKey(key), err)
+	}
+
+	return k, nil
+}
+
+// assignFunc assigns a value to the given element of a path, see [setPath].
+type assignFunc func(elem reflect.Value) error
+
+// assignValue returns the [assignFunc] that converts the given value to the type of the element, see [ValueOfWith].
+func assignValue(val any, opts Options) assignFunc {
+	return func(elem reflect.Value) error {
+		v, err := ValueOfWith(val, elem.Type(), opts)
+		if err != nil {
+			return err
+		}
+
+		elem.Set(v)
+
+		return nil
+	}
+}
+
+/*
+setPath calls `assign` with the element the given path refers to, the value `v` must be addressable.
+Nil pointers, maps and slices are allocated, and slices are grown whenever the index exceeds their length.
+Values of maps and interfaces are not addressable, so they are copied, and written back.
+The value `v` is not changed unless `assign` succeeds.
+*/
+func setPath(v reflect.Value, path Path, i int, opts Options, assign assignFunc) error {
+	errorf := func(err error) error {
+		return grouperror.Prefix(pathPrefix(path, i), err) //nolint:wrapcheck
+	}
+
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if _, err := ValueToKindChain(v); err != nil {
+			return errorf(err)
+		}
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Ptr:
+		if !v.IsNil() {
+			return setPath(v.Elem(), path, i, opts, assign)
+		}
+
+		ptr := reflect.New(v.Type().Elem())
+		if err := setPath(ptr.Elem(), path, i, opts, assign); err != nil {
+			return err
+		}
+
+		v.Set(ptr)
+
+		return nil
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return errorf(fmt.Errorf("unexpected nil %s", v.Type().String()))
+		}
+
+		cp := reflect.New(v.Elem().Type()).Elem()
+		cp.Set(v.Elem())
+
+		if err := setPath(cp, path, i, opts, assign); err != nil {
+			return err
+		}
+
+		v.Set(cp)
+
+		return nil
+	}
+
+	seg := path[i]
+
+	switch {
+	case seg.Kind == StructField:
+		if v.Kind() != reflect.Struct {
+			return errorf(fmt.Errorf("expected struct, %s given", v.Type().String()))
+		}
+
+		if err := fieldNotSupportedError(seg.Field); err != nil {
+			return errorf(err)
+		}
+
+		path, err := matchField(v.Type(), path, i, opts.FieldMatching)
+		if err != nil {
+			return errorf(err)
+		}
+
+		f, err := lookupField(v.Type(), path[i].Field)
+		if err != nil {
+			return errorf(err)
+		}
+
+		if len(f.Index) > 1 {
+			// promoted fields are set through the embedded struct, nil pointers to it are allocated
+			return setPath(accessibleField(v.Field(f.Index[0])), path, i, opts, assign)
+		}
+
+		return setPathElem(accessibleField(v.Field(f.Index[0])), path, i, opts, assign)
+
+	case v.Kind() == reflect.Map:
+		var key any = seg.Index
+		if seg.Kind == MapValue {
+			key = seg.Key
+		}
+
+		return setMapValue(v, key, path, i, opts, assign)
+
+	case seg.Kind == MapValue:
+		return errorf(fmt.Errorf("expected map, %s given", v.Type().String()))
+
+	case v.Kind() == reflect.Slice && seg.Index >= v.Len():
+		grown := reflect.MakeSlice(v.Type(), seg.Index+1, seg.Index+1)
+		reflect.Copy(grown, v)
+
+		if err := setPathElem(grown.Index(seg.Index), path, i, opts, assign); err != nil {
+			return err
+		}
+
+		v.Set(grown)
+
+		return nil
+
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		if seg.Index >= v.Len() {
+			return errorf(fmt.Errorf("index out of range [%d] with length %d", seg.Index, v.Len()))
+		}
+
+		return setPathElem(v.Index(seg.Index), path, i, opts, assign)
+	}
+
+	return errorf(fmt.Errorf("expected slice, array or map, %s given", v.Type().String()))
+}
+
+// setPathElem calls `assign` with the element `elem` of the segment #i, or with the element of its nested path.
+func setPathElem(elem reflect.Value, path Path, i int, opts Options, assign assignFunc) error {
+	if i+1 < len(path) {
+		return setPath(elem, path, i+1, opts, assign)
+	}
+
+	return grouperror.Prefix(pathPrefix(path, i), assign(elem)) //nolint:wrapcheck
+}
+
+// setMapValue calls `assign` with the value of the given key of the map `m`, or with the element of its nested path.
+func setMapValue(m reflect.Value, key any, path Path, i int, opts Options, assign assignFunc) error {
+	k, err := pathMapKey(m.Type(), key)
+	if err != nil {
+		return grouperror.Prefix(pathPrefix(path, i), err) //nolint:wrapcheck
+	}
+
+	elem := reflect.New(m.Type().Elem()).Elem()
+	if !m.IsNil() {
+		if curr := m.MapIndex(k); curr.IsValid() {
+			elem.Set(curr)
+		}
+	}
+
+	if err := setPathElem(elem, path, i, opts, assign); err != nil {
+		return err
+	}
+
+	if m.IsNil() {
+		m.Set(reflect.MakeMap(m.Type()))
+	}
+
+	m.SetMapIndex(k, elem)
+
+	return nil
+}
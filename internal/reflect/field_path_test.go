@@ -0,0 +1,403 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	pool struct {
+		MaxConns int
+		timeout  int
+	}
+
+	database struct {
+		Host string
+		Pool *pool
+	}
+
+	server struct {
+		Host  string
+		Ports map[string]int
+	}
+
+	pathConfig struct {
+		Database database
+		Servers  []server
+		Labels   map[string]string
+		Weights  map[int]float64
+		Matrix   [2][2]int
+		Extra    any
+		servers  []server
+	}
+)
+
+func newPathConfig() *pathConfig {
+	return &pathConfig{
+		Database: database{
+			Host: "localhost",
+			Pool: &pool{MaxConns: 10, timeout: 30},
+		},
+		Servers: []server{
+			{Host: "a.example.com", Ports: map[string]int{"http": 80}},
+			{Host: "b.example.com", Ports: map[string]int{"https": 443}},
+		},
+		Labels:  map[string]string{"env": "prod", `"quoted"`: "yes", "a.b[c]": "dots"},
+		Weights: map[int]float64{7: 0.5},
+		Matrix:  [2][2]int{{1, 2}, {3, 4}},
+		Extra:   &server{Host: "extra.example.com"},
+		servers: []server{{Host: "hidden.example.com"}},
+	}
+}
+
+func TestGet_path(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		scenarios := []struct {
+			path     string
+			expected any
+		}{
+			{path: "Database.Host", expected: "localhost"},
+			{path: "Database.Pool.MaxConns", expected: 10},
+			{path: "Database.Pool.timeout", expected: 30},
+			{path: "Servers[1].Host", expected: "b.example.com"},
+			{path: `Servers[0].Ports["http"]`, expected: 80},
+			{path: `Labels["env"]`, expected: "prod"},
+			{path: `Labels["\"quoted\""]`, expected: "yes"},
+			{path: `Labels["a.b[c]"]`, expected: "dots"},
+			{path: `Weights[7]`, expected: 0.5},
+			{path: `Weights["7"]`, expected: 0.5},
+			{path: "Matrix[1][0]", expected: 3},
+			{path: "Extra.Host", expected: "extra.example.com"},
+			{path: "servers[0].Host", expected: "hidden.example.com"},
+		}
+
+		for _, s := range scenarios {
+			s := s
+
+			t.Run(s.path, func(t *testing.T) {
+				t.Parallel()
+
+				for _, strct := range []any{newPathConfig(), *newPathConfig()} {
+					v, err := reflect.Get(strct, s.path)
+					require.NoError(t, err)
+					assert.Equal(t, s.expected, v)
+				}
+			})
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := newPathConfig()
+		cfg.Servers[1].Ports = nil
+		cfg.Database.Pool = nil
+
+		scenarios := []struct {
+			path  string
+			error string
+		}{
+			{
+				path:  "Database.Pool.MaxConns",
+				error: "Database.Pool.MaxConns: unexpected nil *reflect_test.pool",
+			},
+			{
+				path:  "Database.Port",
+				error: `Database.Port: field "Port" does not exist`,
+			},
+			{
+				path:  "Servers[2].Host",
+				error: "Servers[2]: index out of range [2] with length 2",
+			},
+			{
+				path:  `Servers[1].Ports["http"]`,
+				error: `Servers[1].Ports["http"]: key "http" does not exist`,
+			},
+			{
+				path:  `Weights["seven"]`,
+				error: `Weights["seven"]: key "seven": cannot convert string to int: parsing "seven": invalid syntax`,
+			},
+			{
+				path:  "Database[0]",
+				error: "Database[0]: expected slice, array or map, reflect_test.database given",
+			},
+			{
+				path:  `Servers["a"]`,
+				error: `Servers["a"]: expected map, []reflect_test.server given`,
+			},
+			{
+				path:  "Labels.env",
+				error: "Labels.env: expected struct, map[string]string given",
+			},
+			{
+				path:  "Database._",
+				error: `Database._: "_" is not supported`,
+			},
+			{
+				path:  "Database..Host",
+				error: `invalid path "Database..Host": empty field name at position 9`,
+			},
+			{
+				path:  "[0]",
+				error: `invalid path "[0]": expected field name at position 0`,
+			},
+			{
+				path:  "Servers[-1]",
+				error: `invalid path "Servers[-1]": invalid index [-1]`,
+			},
+			{
+				path:  "Servers[1",
+				error: `invalid path "Servers[1": missing ']' in [1`,
+			},
+			{
+				path:  `Labels["env]`,
+				error: `invalid path "Labels[\"env]": invalid key ["env]`,
+			},
+			{
+				path:  `Labels["env"]x`,
+				error: `invalid path "Labels[\"env\"]x": unexpected 'x' at position 13`,
+			},
+		}
+
+		for _, s := range scenarios {
+			s := s
+
+			t.Run(s.path, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := reflect.Get(cfg, s.path)
+				assert.EqualError(t, err, fmt.Sprintf("get (*reflect_test.pathConfig).%+q: %s", s.path, s.error))
+			})
+		}
+	})
+}
+
+func TestSet_path(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		scenarios := []struct {
+			path     string
+			value    any
+			expected func(*pathConfig) any
+		}{
+			{
+				path:     "Database.Pool.MaxConns",
+				value:    "20",
+				expected: func(c *pathConfig) any { return c.Database.Pool.MaxConns },
+			},
+			{
+				path:     "Database.Pool.timeout",
+				value:    60,
+				expected: func(c *pathConfig) any { return c.Database.Pool.timeout },
+			},
+			{
+				path:     "Servers[1].Host",
+				value:    "c.example.com",
+				expected: func(c *pathConfig) any { return c.Servers[1].Host },
+			},
+			{
+				path:     `Servers[0].Ports["https"]`,
+				value:    443,
+				expected: func(c *pathConfig) any { return c.Servers[0].Ports },
+			},
+			{
+				path:     `Labels["env"]`,
+				value:    "dev",
+				expected: func(c *pathConfig) any { return c.Labels["env"] },
+			},
+			{
+				path:     `Weights["7"]`,
+				value:    1,
+				expected: func(c *pathConfig) any { return c.Weights[7] },
+			},
+			{
+				path:     "Matrix[1][0]",
+				value:    30,
+				expected: func(c *pathConfig) any { return c.Matrix },
+			},
+			{
+				path:     "Extra.Host",
+				value:    "new.example.com",
+				expected: func(c *pathConfig) any { return c.Extra.(*server).Host }, //nolint:forcetypeassert
+			},
+			{
+				path:     "servers[0].Host",
+				value:    "visible.example.com",
+				expected: func(c *pathConfig) any { return c.servers[0].Host },
+			},
+		}
+
+		expected := []any{
+			20,
+			60,
+			"c.example.com",
+			map[string]int{"http": 80, "https": 443},
+			"dev",
+			1.0,
+			[2][2]int{{1, 2}, {30, 4}},
+			"new.example.com",
+			"visible.example.com",
+		}
+
+		for i, s := range scenarios {
+			s, expected := s, expected[i]
+
+			t.Run(s.path, func(t *testing.T) {
+				t.Parallel()
+
+				cfg := newPathConfig()
+				require.NoError(t, reflect.Set(&cfg, s.path, s.value, true))
+				assert.Equal(t, expected, s.expected(cfg))
+			})
+		}
+	})
+	t.Run("Allocate", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg pathConfig
+
+		require.NoError(t, reflect.Set(&cfg, "Database.Pool.MaxConns", 10, false))
+		require.NoError(t, reflect.Set(&cfg, `Servers[2].Ports["http"]`, 80, false))
+		require.NoError(t, reflect.Set(&cfg, `Labels["env"]`, "prod", false))
+
+		assert.Equal(t, &pool{MaxConns: 10}, cfg.Database.Pool)
+		assert.Equal(t, []server{{}, {}, {Ports: map[string]int{"http": 80}}}, cfg.Servers)
+		assert.Equal(t, map[string]string{"env": "prod"}, cfg.Labels)
+	})
+	t.Run("Map of structs", func(t *testing.T) {
+		t.Parallel()
+
+		servers := struct {
+			ByName map[string]server
+		}{
+			ByName: map[string]server{"a": {Host: "a.example.com", Ports: map[string]int{"http": 80}}},
+		}
+
+		require.NoError(t, reflect.Set(&servers, `ByName["a"].Host`, "a2.example.com", false))
+		require.NoError(t, reflect.Set(&servers, `ByName["b"].Ports["http"]`, 8080, false))
+
+		assert.Equal(
+			t,
+			map[string]server{
+				"a": {Host: "a2.example.com", Ports: map[string]int{"http": 80}},
+				"b": {Ports: map[string]int{"http": 8080}},
+			},
+			servers.ByName,
+		)
+	})
+	t.Run("Interface", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg any = pathConfig{Extra: server{Host: "a.example.com"}}
+
+		require.NoError(t, reflect.Set(&cfg, "Extra.Host", "b.example.com", false))
+		assert.Equal(t, server{Host: "b.example.com"}, cfg.(pathConfig).Extra) //nolint:forcetypeassert
+	})
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		scenarios := []struct {
+			path  string
+			value any
+			error string
+		}{
+			{
+				path:  "Database.Pool.MaxConns",
+				value: "many",
+				error: `Database.Pool.MaxConns: cannot convert string to int: parsing "many": invalid syntax`,
+			},
+			{
+				path:  `Servers[5].Ports["http"]`,
+				value: "eighty",
+				error: `Servers[5].Ports["http"]: cannot convert string to int: parsing "eighty": invalid syntax`,
+			},
+			{
+				path:  "Matrix[2][0]",
+				value: 1,
+				error: "Matrix[2]: index out of range [2] with length 2",
+			},
+			{
+				path:  `Weights["seven"]`,
+				value: 1,
+				error: `Weights["seven"]: key "seven": cannot convert string to int: parsing "seven": invalid syntax`,
+			},
+			{
+				path:  "Extra.Host",
+				value: "localhost",
+				error: "Extra.Host: unexpected nil interface {}",
+			},
+			{
+				path:  "Database.Port",
+				value: 5432,
+				error: `Database.Port: field "Port" does not exist`,
+			},
+			{
+				path:  `Database["Host"]`,
+				value: "localhost",
+				error: `Database["Host"]: expected map, reflect_test.database given`,
+			},
+			{
+				path:  "Labels[0].Name",
+				value: "env",
+				error: "Labels[0].Name: expected struct, string given",
+			},
+		}
+
+		for _, s := range scenarios {
+			s := s
+
+			t.Run(s.path, func(t *testing.T) {
+				t.Parallel()
+
+				var cfg pathConfig
+
+				err := reflect.Set(&cfg, s.path, s.value, true)
+				assert.EqualError(t, err, fmt.Sprintf("set (*reflect_test.pathConfig).%+q: %s", s.path, s.error))
+				assert.Equal(t, pathConfig{}, cfg, "the struct must be unchanged")
+			})
+		}
+	})
+	t.Run("Pointer loop", func(t *testing.T) {
+		t.Parallel()
+
+		var loop any
+		loop = &loop
+
+		cfg := pathConfig{Extra: loop}
+		err := reflect.Set(&cfg, "Extra.Host", "localhost", true)
+		assert.EqualError(t, err, `set (*reflect_test.pathConfig)."Extra.Host": Extra.Host: unexpected pointer loop`)
+
+		_, err = reflect.Get(&cfg, "Extra.Host")
+		assert.EqualError(t, err, `get (*reflect_test.pathConfig)."Extra.Host": Extra.Host: unexpected pointer loop`)
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gontainer/grouperror"
+)
+
+// Field describes a field of a struct, see [Fields].
+type Field struct {
+	// Name is the name of the field.
+	Name string
+	// Index is the index sequence of the field, see [reflect.Value.FieldByIndex].
+	Index []int
+	// Type is the type of the field.
+	Type reflect.Type
+	// Tag is the tag of the field.
+	Tag reflect.StructTag
+	// Exported is true for exported fields.
+	Exported bool
+	// Embedded is true for embedded fields.
+	Embedded bool
+	// Value is the current value of the field.
+	Value any
+}
+
+/*
+Fields returns fields of the given struct in the order of declaration.
+Fields of embedded structs follow the embedded field, their indexes start with the index of the embedded field.
+Fields of nil embedded pointers are omitted. Values of unexported fields are accessible, see [accessibleField].
+*/
+func Fields(strct any) (_ []Field, err error) {
+	defer func() {
+		if err != nil {
+			err = grouperror.Prefix(fmt.Sprintf("fields (%T): ", strct), err)
+		}
+	}()
+
+	v, err := structValue(strct)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendFields(nil, addressable(v), nil, make(map[uintptr]struct{})), nil
+}
+
+// appendFields appends fields of the given addressable struct, `visited` prevents loops of embedded pointers.
+func appendFields(r []Field, strct reflect.Value, index []int, visited map[uintptr]struct{}) []Field {
+	t := strct.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		f := accessibleField(strct.Field(i))
+		idx := append(index[:len(index):len(index)], i)
+
+		r = append(r, Field{
+			Name:     sf.Name,
+			Index:    idx,
+			Type:     sf.Type,
+			Tag:      sf.Tag,
+			Exported: sf.PkgPath == "",
+			Embedded: sf.Anonymous,
+			Value:    f.Interface(),
+		})
+
+		if !sf.Anonymous {
+			continue
+		}
+
+		if f.Kind() == reflect.Ptr {
+			if f.IsNil() || f.Elem().Kind() != reflect.Struct {
+				continue
+			}
+
+			if _, ok := visited[f.Pointer()]; ok {
+				continue
+			}
+
+			p := f.Pointer()
+			visited[p] = struct{}{}
+			r = appendFields(r, f.Elem(), idx, visited)
+
+			delete(visited, p)
+
+			continue
+		}
+
+		if f.Kind() == reflect.Struct {
+			r = appendFields(r, f, idx, visited)
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	stdReflect "reflect"
+	"testing"
+
+	"github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	fieldsBase struct {
+		ID int `yaml:"id"`
+	}
+
+	fieldsNode struct {
+		*fieldsNode
+		Name string
+	}
+
+	fieldsUser struct {
+		fieldsBase
+		*tagAudit
+		Name   string `yaml:"name"`
+		secret string
+		_      int
+	}
+)
+
+func TestFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		u := fieldsUser{
+			fieldsBase: fieldsBase{ID: 5},
+			Name:       "Mary",
+			secret:     "password",
+		}
+
+		fields, err := reflect.Fields(&u)
+		require.NoError(t, err)
+
+		intType := stdReflect.TypeOf(0)
+		strType := stdReflect.TypeOf("")
+
+		expected := []reflect.Field{
+			{
+				Name:     "fieldsBase",
+				Index:    []int{0},
+				Type:     stdReflect.TypeOf(fieldsBase{}),
+				Embedded: true,
+				Value:    fieldsBase{ID: 5},
+			},
+			{Name: "ID", Index: []int{0, 0}, Type: intType, Tag: `yaml:"id"`, Exported: true, Value: 5},
+			{
+				Name:     "tagAudit",
+				Index:    []int{1},
+				Type:     stdReflect.TypeOf(&tagAudit{}),
+				Embedded: true,
+				Value:    (*tagAudit)(nil),
+			},
+			{Name: "Name", Index: []int{2}, Type: strType, Tag: `yaml:"name"`, Exported: true, Value: "Mary"},
+			{Name: "secret", Index: []int{3}, Type: strType, Value: "password"},
+			{Name: "_", Index: []int{4}, Type: intType, Value: 0},
+		}
+
+		assert.Equal(t, expected, fields)
+	})
+
+	t.Run("Non-nil embedded pointer", func(t *testing.T) {
+		t.Parallel()
+
+		fields, err := reflect.Fields(fieldsUser{tagAudit: &tagAudit{CreatedBy: "admin"}})
+		require.NoError(t, err)
+
+		names := make([]string, len(fields))
+		for i, f := range fields {
+			names[i] = f.Name
+		}
+
+		assert.Equal(
+			t,
+			[]string{"fieldsBase", "ID", "tagAudit", "CreatedBy", "UpdatedBy", "Version", "Name", "secret", "_"},
+			names,
+		)
+		assert.Equal(t, []int{1, 0}, fields[3].Index)
+		assert.Equal(t, "admin", fields[3].Value)
+	})
+
+	t.Run("Loop of embedded pointers", func(t *testing.T) {
+		t.Parallel()
+
+		n := fieldsNode{Name: "node"}
+		n.fieldsNode = &n
+
+		fields, err := reflect.Fields(n)
+		require.NoError(t, err)
+		require.Len(t, fields, 4)
+		assert.Equal(t, []int{0, 0}, fields[1].Index)
+		assert.Equal(t, []int{0, 1}, fields[2].Index)
+		assert.Equal(t, "Name", fields[3].Name)
+		assert.Equal(t, []int{1}, fields[3].Index)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reflect.Fields(5)
+		assert.EqualError(t, err, "fields (int): expected struct, int given")
+
+		_, err = reflect.Fields((*fieldsUser)(nil))
+		assert.EqualError(t, err, "fields (*reflect_test.fieldsUser): pointer to nil struct given")
+	})
+}
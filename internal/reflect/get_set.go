@@ -52,16 +52,50 @@ func fieldNotSupportedError(field string) error {
 	return nil
 }
 
-func fieldByName(strct reflect.Value, field string) (f reflect.Value, err error) {
-	f = strct.FieldByName(field)
-	if !f.IsValid() {
-		err = fmt.Errorf("field %+q does not exist", field)
+// lookupField returns the field of the given struct,
+// fields of embedded structs are promoted, see [reflect.Type.FieldByName].
+func lookupField(strct reflect.Type, field string) (reflect.StructField, error) {
+	f, ok := strct.FieldByName(field)
+	if !ok {
+		return reflect.StructField{}, fmt.Errorf("field %+q does not exist", field)
 	}
 
-	return
+	return f, nil
 }
 
-func Get(strct any, field string) (_ any, err error) { //nolint:cyclop,ireturn
+// fieldByName returns the accessible field of the given struct, see [lookupField].
+// Fields promoted from nil pointers to embedded structs cannot be read.
+func fieldByName(strct reflect.Value, field string) (reflect.Value, error) {
+	f, err := lookupField(strct.Type(), field)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	v := addressable(strct)
+
+	for i, x := range f.Index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, fmt.Errorf("field %+q is promoted from nil %s", field, v.Type().String())
+			}
+
+			v = v.Elem()
+		}
+
+		v = accessibleField(v.Field(x))
+	}
+
+	return v, nil
+}
+
+// Get returns the value of the given field, or the given path, e.g. `Servers[2].Host`, see [parseFieldPath].
+// It applies [DefaultOptions], see [GetWith].
+func Get(strct any, field string) (any, error) { //nolint:ireturn
+	return GetWith(strct, field, DefaultOptions())
+}
+
+// GetWith works similar to [Get], but it applies the given options instead of [DefaultOptions].
+func GetWith(strct any, field string, opts Options) (_ any, err error) { //nolint:ireturn
 	defer func() {
 		if err != nil {
 			err = grouperror.Prefix(fmt.Sprintf("get (%T).%+q: ", strct, field), err)
@@ -72,11 +106,50 @@ func Get(strct any, field string) (_ any, err error) { //nolint:cyclop,ireturn
 		return nil, err
 	}
 
+	path, err := parseFieldPath(field)
+	if err != nil {
+		return nil, err
+	}
+
+	return get(strct, staticPath(path), opts.FieldMatching)
+}
+
+// pathResolver returns the path to the field of the given type of struct.
+type pathResolver func(strct reflect.Type) (Path, error)
+
+func staticPath(p Path) pathResolver {
+	return func(reflect.Type) (Path, error) {
+		return p, nil
+	}
+}
+
+//nolint:ireturn
+func get(strct any, resolve pathResolver, m FieldMatching) (any, error) {
+	reflectVal, err := structValue(strct)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := resolve(reflectVal.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := getPath(reflectVal, path, m)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Interface(), nil
+}
+
+// structValue dereferences pointers and interfaces that wrap the given struct.
+func structValue(strct any) (reflect.Value, error) {
 	reflectVal := reflect.ValueOf(strct)
 
 	chain, err := ValueToKindChain(reflectVal)
 	if err != nil {
-		return nil, err
+		return reflect.Value{}, err
 	}
 
 	for len(chain) > 1 {
@@ -87,33 +160,28 @@ func Get(strct any, field string) (_ any, err error) { //nolint:cyclop,ireturn
 	if reflectVal.Kind() != reflect.Struct {
 		if !reflectVal.IsValid() {
 			if err := ptrToNilStructError(strct); err != nil {
-				return nil, err
+				return reflect.Value{}, err
 			}
 		}
 
-		return nil, fmt.Errorf("expected struct, %T given", strct)
-	}
-
-	f, err := fieldByName(reflectVal, field)
-	if err != nil {
-		return nil, err
+		return reflect.Value{}, fmt.Errorf("expected struct, %T given", strct)
 	}
 
-	if !f.CanSet() { // handle unexported fields
-		if !f.CanAddr() {
-			tmpReflectVal := reflect.New(reflectVal.Type()).Elem()
-			tmpReflectVal.Set(reflectVal)
-			f = tmpReflectVal.FieldByName(field)
-		}
+	return reflectVal, nil
+}
 
-		f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
-	}
+// Set assigns the given value to the given field, or the given path, e.g. `Servers[2].Host`, see [setPath].
+func Set(strct any, field string, val any, convert bool) error {
+	return SetWith(strct, field, val, ConvertOptions(convert))
+}
 
-	return f.Interface(), nil
+// SetWith works similar to [Set], but it applies the given options instead of [DefaultOptions].
+func SetWith(strct any, field string, val any, opts Options) error {
+	return setField(strct, field, opts, assignValue(val, opts))
 }
 
-//nolint:cyclop
-func Set(strct any, field string, val any, convert bool) (err error) {
+// setField calls `assign` with the field, or the element, the given path refers to, see [setPath].
+func setField(strct any, field string, opts Options, assign assignFunc) (err error) {
 	defer func() {
 		if err != nil {
 			err = grouperror.Prefix(fmt.Sprintf("set (%T).%+q: ", strct, field), err)
@@ -124,6 +192,31 @@ func Set(strct any, field string, val any, convert bool) (err error) {
 		return err
 	}
 
+	path, err := parseFieldPath(field)
+	if err != nil {
+		return err
+	}
+
+	return set(strct, staticPath(path), opts, assign)
+}
+
+func set(strct any, resolve pathResolver, opts Options, assign assignFunc) error {
+	return setStruct(strct, func(v reflect.Value) error {
+		path, err := resolve(v.Type())
+		if err != nil {
+			return err
+		}
+
+		return setPath(v, path, 0, opts, assign)
+	})
+}
+
+/*
+setStruct calls `assign` with the addressable struct the given pointer refers to.
+If the struct is wrapped by an interface, `assign` receives its copy, and the copy is written back on success.
+*/
+//nolint:cyclop
+func setStruct(strct any, assign func(strct reflect.Value) error) error {
 	reflectVal := reflect.ValueOf(strct)
 
 	chain, err := ValueToKindChain(reflectVal)
@@ -164,12 +257,7 @@ func Set(strct any, field string, val any, convert bool) (err error) {
 	// s := struct{ val int }{}
 	// Set(&s...
 	case chain.equalTo(reflect.Ptr, reflect.Struct):
-		return setOnValue(
-			reflectVal.Elem(),
-			field,
-			val,
-			convert,
-		)
+		return assign(reflectVal.Elem())
 
 	// var s any = struct{ val int }{}
 	// Set(&s...
@@ -178,7 +266,7 @@ func Set(strct any, field string, val any, convert bool) (err error) {
 		tmp := reflect.New(v.Elem().Type()).Elem()
 		tmp.Set(v.Elem())
 
-		if err := setOnValue(tmp, field, val, convert); err != nil {
+		if err := assign(tmp); err != nil {
 			return err
 		}
 
@@ -195,24 +283,27 @@ func Set(strct any, field string, val any, convert bool) (err error) {
 	}
 }
 
-func setOnValue(strct reflect.Value, field string, val any, convert bool) error {
-	f, err := fieldByName(strct, field)
-	if err != nil {
-		return err
+// accessibleField allows for reading and writing unexported fields.
+//
+// f.CanAddr() MUST BE equal to true.
+func accessibleField(f reflect.Value) reflect.Value {
+	if f.CanSet() {
+		return f
 	}
 
-	v, err := ValueOf(val, f.Type(), convert)
-	if err != nil {
-		return err
-	}
+	return reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+}
 
-	if !f.CanSet() { // handle unexported fields
-		f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+// addressable returns an addressable copy of the given value, or the value itself if it is addressable already.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
 	}
 
-	f.Set(v)
+	cp := reflect.New(v.Type()).Elem()
+	cp.Set(v)
 
-	return nil
+	return cp
 }
 
 type kindChain []reflect.Kind
@@ -0,0 +1,241 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Options configure conversions.
+type Options struct {
+	// AssignOnly disables conversions, values must be assignable to the target type.
+	AssignOnly bool
+	// NilAsZero converts the untyped nil to the zero value of any type.
+	// By default, only types of nil-able kinds, e.g. pointers and maps, accept nil.
+	NilAsZero bool
+	// BeforeBuiltIn are custom converters applied before the built-in ones,
+	// they take precedence over the registered ones, see [RegisterConverter].
+	BeforeBuiltIn []ConverterFunc
+	// AfterBuiltIn are custom converters applied after the built-in ones,
+	// they take precedence over the registered ones.
+	AfterBuiltIn []ConverterFunc
+	// Strict rejects conversions of numbers that would overflow or lose precision.
+	Strict bool
+	// RuneConversion converts integers to strings as runes, e.g. 65 to "A", instead of "65".
+	RuneConversion bool
+	// UnmatchedFields defines how to handle fields without counterparts while converting structs.
+	UnmatchedFields UnmatchedFields
+	// ArrayLength defines how to handle slices and arrays of lengths different from the length of the target array.
+	ArrayLength ArrayLength
+	// KeyCollisions defines how to handle keys of maps converted to the same key,
+	// or matching the same field of a struct, see [Options.CaseInsensitiveKeys].
+	KeyCollisions KeyCollisions
+	// Cycles defines how to handle maps, slices and pointers that refer to themselves.
+	Cycles Cycles
+	// FieldMatching defines how names given to [Get] and [Set] match names of fields.
+	FieldMatching FieldMatching
+	// TagName is the name of the struct tag that overrides names of fields in conversions.
+	// Empty value means [DefaultTagName].
+	TagName string
+	// CaseInsensitiveKeys matches keys of maps to fields of structs regardless of the case.
+	CaseInsensitiveKeys bool
+	// EncodeUnexportedFields includes unexported fields while encoding structs into maps.
+	EncodeUnexportedFields bool
+	// StringerFallback converts types implementing [fmt.Stringer] to strings,
+	// unless they implement [encoding.TextMarshaler].
+	StringerFallback bool
+	// DurationUnit is the unit of integers and integral floats converted to [time.Duration], e.g. [time.Second].
+	// Zero value means integers are converted as nanoseconds.
+	DurationUnit time.Duration
+	// TimeLayouts are the layouts used to parse strings converted to [time.Time].
+	// Empty value means [time.RFC3339].
+	TimeLayouts []string
+}
+
+// planOptions are the options that affect the choice of converters, see [conversion.plan].
+type planOptions struct {
+	runeConversion   bool
+	stringerFallback bool
+	durationUnit     bool
+}
+
+func (o Options) planOptions() planOptions {
+	return planOptions{
+		runeConversion:   o.RuneConversion,
+		stringerFallback: o.StringerFallback,
+		durationUnit:     o.DurationUnit != 0,
+	}
+}
+
+func (o Options) tagName() string {
+	if o.TagName == "" {
+		return DefaultTagName
+	}
+
+	return o.TagName
+}
+
+func (o Options) timeLayouts() []string {
+	if len(o.TimeLayouts) == 0 {
+		return []string{time.RFC3339}
+	}
+
+	return o.TimeLayouts
+}
+
+// UnmatchedFields defines how to handle fields without counterparts while converting structs.
+type UnmatchedFields uint8
+
+const (
+	// IgnoreUnmatchedFields leaves target fields without source unchanged, and skips source fields without target.
+	// Structs without any matching fields are rejected, unless [AllowNoMatchingFields] is given.
+	IgnoreUnmatchedFields UnmatchedFields = 0
+	// RejectMissingFields rejects target fields without source.
+	RejectMissingFields UnmatchedFields = 1
+	// RejectUnknownFields rejects source fields without target.
+	RejectUnknownFields UnmatchedFields = 2
+	// RejectUnmatchedFields rejects fields without counterparts in both structs.
+	RejectUnmatchedFields = RejectMissingFields | RejectUnknownFields
+	// AllowNoMatchingFields converts structs without any matching fields to zero values.
+	AllowNoMatchingFields UnmatchedFields = 4
+)
+
+//nolint:gochecknoglobals
+var (
+	defaults struct {
+		sync.RWMutex
+		opts Options
+	}
+)
+
+// DefaultOptions returns the options applied to all conversions.
+func DefaultOptions() Options {
+	defaults.RLock()
+	defer defaults.RUnlock()
+
+	return defaults.opts
+}
+
+// Option configures [Options].
+type Option func(*Options)
+
+// ApplyOptions returns the given options with the given [Option] applied on top of them.
+func ApplyOptions(o Options, opts ...Option) Options {
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// NewOptions returns [DefaultOptions] with the given [Option] applied on top of them.
+func NewOptions(opts ...Option) Options {
+	return ApplyOptions(DefaultOptions(), opts...)
+}
+
+// ConvertOptions returns [DefaultOptions], values must be assignable unless `convert` equals true,
+// see [Options.AssignOnly].
+func ConvertOptions(convert bool) Options {
+	o := DefaultOptions()
+	o.AssignOnly = !convert
+
+	return o
+}
+
+// SetDefaultOptions sets the options applied to all conversions.
+func SetDefaultOptions(o Options) {
+	defaults.Lock()
+	defer defaults.Unlock()
+
+	defaults.opts = o
+}
+
+// ArrayLength defines how to handle slices and arrays of lengths different from the length of the target array.
+type ArrayLength uint8
+
+const (
+	// TruncateOrPad truncates longer sources, and pads shorter ones with zero values.
+	TruncateOrPad ArrayLength = 0
+	// Truncate truncates longer sources, and rejects shorter ones.
+	Truncate ArrayLength = 1
+	// Pad pads shorter sources with zero values, and rejects longer ones.
+	Pad ArrayLength = 2
+	// Exact rejects sources of lengths different from the length of the target array.
+	Exact = Truncate | Pad
+)
+
+func (a ArrayLength) rejectsPadding() bool {
+	return a&Truncate != 0
+}
+
+func (a ArrayLength) rejectsTruncation() bool {
+	return a&Pad != 0
+}
+
+// check returns an error if the given lengths violate the policy.
+func (a ArrayLength) check(from, to int) error {
+	switch {
+	case from > to && a.rejectsTruncation():
+		return fmt.Errorf("source length %d exceeds target length %d", from, to)
+	case from < to && a.rejectsPadding():
+		return fmt.Errorf("source length %d is less than target length %d", from, to)
+	}
+
+	return nil
+}
+
+// KeyCollisions defines how to handle keys of maps converted to the same key.
+type KeyCollisions uint8
+
+const (
+	// RejectKeyCollisions returns an error that lists the colliding keys.
+	RejectKeyCollisions KeyCollisions = iota
+	// FirstKeyWins keeps the value of the first colliding key, see [lessKey].
+	FirstKeyWins
+	// LastKeyWins keeps the value of the last colliding key, see [lessKey].
+	LastKeyWins
+)
+
+// Cycles defines how to handle maps, slices and pointers that refer to themselves.
+type Cycles uint8
+
+const (
+	// PreserveCycles makes the result refer to itself the same way.
+	// Cycles that go through values that cannot refer to themselves, e.g. arrays, are rejected.
+	PreserveCycles Cycles = iota
+	// RejectCycles returns an error.
+	RejectCycles
+)
+
+// FieldMatching defines how names given to [Get] and [Set] match names of fields.
+type FieldMatching uint8
+
+const (
+	// MatchExact matches names that are equal.
+	MatchExact FieldMatching = iota
+	// MatchCaseInsensitive matches names regardless of the case, e.g. "maxconns" matches "MaxConns".
+	MatchCaseInsensitive
+	// MatchNormalized matches names regardless of the case, underscores and hyphens,
+	// e.g. "MAX_CONNS" and "max-conns" match "MaxConns".
+	MatchNormalized
+)
@@ -0,0 +1,173 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+//nolint:gochecknoglobals
+var (
+	plans             sync.Map // map[planKey]*plan
+	structFieldsCache sync.Map // map[structFieldsKey]structFieldsResult
+
+	// plansDisabled makes conversions walk the whole chain of converters, so benchmarks can compare both ways.
+	plansDisabled uint32
+)
+
+type planKey struct {
+	from, to reflect.Type
+	opts     planOptions
+	registry uint64
+}
+
+/*
+plan holds the converters that may support a pair of concrete types, in the order they are applied.
+Converters whose type-level checks reject the types are skipped, see [checker].
+Converters without type-level checks, e.g. [ConverterFunc], are always candidates.
+*/
+type plan struct {
+	converters []converter
+}
+
+/*
+plan returns the plan compiled for the given pair of types.
+Plans are compiled once, and cached for the given options and the registered converters.
+*/
+func (c *conversion) plan(from, to reflect.Type) *plan {
+	if atomic.LoadUint32(&plansDisabled) == 1 {
+		return &plan{converters: c.chain()}
+	}
+
+	pair := typePair{from: from, to: to}
+
+	// elements of slices and maps usually share types, so plans are memoized for the given conversion
+	if p, ok := c.plans[pair]; ok {
+		return p
+	}
+
+	var p *plan
+
+	// converters given in options apply to the given conversion only, so its plans cannot be shared
+	if c.opts.hasCustomConverters() {
+		p = c.compile(from, to)
+	} else {
+		key := planKey{
+			from:     from,
+			to:       to,
+			opts:     c.opts.planOptions(),
+			registry: registryVersion(),
+		}
+
+		if cached, ok := plans.Load(key); ok {
+			p = cached.(*plan) //nolint:forcetypeassert
+		} else {
+			p = c.compile(from, to)
+			plans.Store(key, p)
+		}
+	}
+
+	if c.plans == nil {
+		c.plans = make(map[typePair]*plan)
+	}
+
+	c.plans[pair] = p
+
+	return p
+}
+
+/*
+elemPlan returns the plan for elements of the given type, e.g. values of maps.
+It returns nil for interfaces, since types of their values are known at runtime only.
+*/
+func (c *conversion) elemPlan(from, to reflect.Type) *plan {
+	if from.Kind() == reflect.Interface {
+		return nil
+	}
+
+	return c.plan(from, to)
+}
+
+// compile returns the plan for the given types.
+func (c *conversion) compile(from, to reflect.Type) *plan {
+	p := &plan{}
+
+	for _, cv := range c.chain() {
+		if ch, ok := cv.(checker); ok {
+			if _, supports, _ := ch.check(from, to, c); !supports {
+				continue
+			}
+		}
+
+		p.converters = append(p.converters, cv)
+	}
+
+	return p
+}
+
+// chain returns all converters in the order they are applied, see [conversion.converters].
+func (c *conversion) chain() []converter {
+	var r []converter
+
+	for _, group := range c.converters() {
+		r = append(r, group...)
+	}
+
+	return r
+}
+
+// clearPlans evicts cached plans whenever the registry changes.
+// Plans are keyed by the version of the registry, so outdated ones are never used, but they would stay in memory.
+func clearPlans() {
+	plans.Range(func(key, _ any) bool {
+		plans.Delete(key)
+
+		return true
+	})
+}
+
+type structFieldsKey struct {
+	t       reflect.Type
+	tagName string
+}
+
+type structFieldsResult struct {
+	fields fieldList
+	err    error
+}
+
+// cachedStructFields returns [structFields] cached per type and tag name.
+func cachedStructFields(t reflect.Type, tagName string) (fieldList, error) {
+	key := structFieldsKey{t: t, tagName: tagName}
+
+	if r, ok := structFieldsCache.Load(key); ok {
+		r := r.(structFieldsResult) //nolint:forcetypeassert
+
+		return r.fields, r.err
+	}
+
+	fields, err := structFields(t, tagName)
+	structFieldsCache.Store(key, structFieldsResult{fields: fields, err: err})
+
+	return fields, err
+}
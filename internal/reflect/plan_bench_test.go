@@ -0,0 +1,123 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	intReflect "github.com/gontainer/reflectpro/internal/reflect"
+)
+
+type benchItem struct {
+	ID    int
+	Name  string
+	Score float64
+	Tags  []string
+}
+
+type benchItemDTO struct {
+	ID    string
+	Name  string
+	Score string
+	Tags  []any
+}
+
+const benchSize = 1000
+
+// benchmarkPlans compares conversions with plans, see [intReflect.DisablePlans], and without them.
+func benchmarkPlans(b *testing.B, from any, to reflect.Type) {
+	b.Helper()
+
+	run := func(b *testing.B) {
+		b.Helper()
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			if _, err := intReflect.ValueOf(from, to, true); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.Run("plans", run)
+	b.Run("no plans", func(b *testing.B) {
+		intReflect.DisablePlans(b)
+		run(b)
+	})
+}
+
+func BenchmarkValueOf_slice(b *testing.B) {
+	from := make([]any, benchSize)
+	for i := range from {
+		from[i] = i
+	}
+
+	benchmarkPlans(b, from, reflect.TypeOf([]int64{}))
+}
+
+func BenchmarkValueOf_typedSlice(b *testing.B) {
+	from := make([]string, benchSize)
+	for i := range from {
+		from[i] = strconv.Itoa(i)
+	}
+
+	benchmarkPlans(b, from, reflect.TypeOf([]int64{}))
+}
+
+func BenchmarkValueOf_map(b *testing.B) {
+	from := make(map[string]any, benchSize)
+	for i := 0; i < benchSize; i++ {
+		from[strconv.Itoa(i)] = strconv.Itoa(i)
+	}
+
+	benchmarkPlans(b, from, reflect.TypeOf(map[int]int{}))
+}
+
+func BenchmarkValueOf_structs(b *testing.B) {
+	from := make([]benchItemDTO, benchSize)
+	for i := range from {
+		from[i] = benchItemDTO{
+			ID:    strconv.Itoa(i),
+			Name:  "item",
+			Score: "1.5",
+			Tags:  []any{"a", "b"},
+		}
+	}
+
+	benchmarkPlans(b, from, reflect.TypeOf([]benchItem{}))
+}
+
+func BenchmarkValueOf_mapsToStructs(b *testing.B) {
+	from := make([]any, benchSize)
+	for i := range from {
+		from[i] = map[string]any{
+			"ID":    i,
+			"Name":  "item",
+			"Score": 1.5,
+			"Tags":  []any{"a", "b"},
+		}
+	}
+
+	benchmarkPlans(b, from, reflect.TypeOf([]benchItem{}))
+}
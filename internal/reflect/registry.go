@@ -0,0 +1,106 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// ConverterFunc converts the value `from` to the type `to`.
+// It returns supports == false whenever it does not support the given pair of types,
+// the next converter in the chain is used then.
+// The third argument converts nested values, e.g. elements of slices.
+type ConverterFunc = func(
+	from reflect.Value,
+	to reflect.Type,
+	convert generalConverter,
+) (
+	_ reflect.Value,
+	supports bool,
+	_ error,
+)
+
+//nolint:gochecknoglobals
+var (
+	// version is incremented whenever a converter is registered, it invalidates compiled plans.
+	version uint64
+
+	registry struct {
+		sync.RWMutex
+		before []converter
+		after  []converter
+	}
+)
+
+// RegisterConverter registers a custom converter.
+// Converters registered with beforeBuiltIn == true take precedence over the built-in ones,
+// the remaining ones are used whenever none of the built-in converters supports the given types.
+func RegisterConverter(fn ConverterFunc, beforeBuiltIn bool) {
+	register(customConverter(fn), beforeBuiltIn)
+}
+
+// RegisterTypedConverter registers a custom converter that supports the types accepted by `supports`.
+// Unlike [RegisterConverter], it lets [CanConvert] inspect the converter without a value.
+func RegisterTypedConverter(fn ConverterFunc, supports func(from, to reflect.Type) bool, beforeBuiltIn bool) {
+	register(typedConverter{customConverter: customConverter(fn), supports: supports}, beforeBuiltIn)
+}
+
+func register(c converter, beforeBuiltIn bool) {
+	registry.Lock()
+	defer registry.Unlock()
+	defer clearPlans()
+	defer atomic.AddUint64(&version, 1)
+
+	if beforeBuiltIn {
+		registry.before = append(registry.before, c)
+
+		return
+	}
+
+	registry.after = append(registry.after, c)
+}
+
+func registryVersion() uint64 {
+	return atomic.LoadUint64(&version)
+}
+
+type typedConverter struct {
+	customConverter
+	supports func(from, to reflect.Type) bool
+}
+
+func (t typedConverter) check(from, to reflect.Type, _ *conversion) (_ Verdict, supports bool, reason string) {
+	if !t.supports(from, to) {
+		return No, false, ""
+	}
+
+	return Maybe, true, fmt.Sprintf("custom converter of %s to %s may return an error", from.String(), to.String())
+}
+
+func registeredConverters() (before, after []converter) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	return registry.before, registry.after
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+
+	"github.com/gontainer/grouperror"
+)
+
+/*
+SetMany assigns the given values to the given fields, or paths, see [SetWith].
+Fields are set in the lexicographical order, all errors are returned as one [grouperror] collection.
+If `atomic` is true, all values are converted to types of their fields first,
+and the struct is not changed unless all of them are converted successfully.
+*/
+func SetMany(strct any, vals map[string]any, opts Options, atomic bool) error {
+	fields := make([]string, 0, len(vals))
+	for f := range vals {
+		fields = append(fields, f)
+	}
+
+	sort.Strings(fields)
+
+	assign := func(f string) assignFunc {
+		return assignValue(vals[f], opts)
+	}
+
+	if atomic {
+		converted, err := convertMany(strct, fields, vals, opts)
+		if err != nil {
+			return err
+		}
+
+		assign = func(f string) assignFunc {
+			return func(elem reflect.Value) error {
+				// the type of the element may differ if the preceding field replaced the value of an interface
+				if v := converted[f]; v.Type() == elem.Type() {
+					elem.Set(v)
+
+					return nil
+				}
+
+				return assignValue(vals[f], opts)(elem)
+			}
+		}
+	}
+
+	errs := make([]error, 0, len(fields))
+
+	for _, f := range fields {
+		errs = append(errs, setField(strct, f, opts, assign(f)))
+	}
+
+	return grouperror.Join(errs...) //nolint:wrapcheck
+}
+
+// errDryRun prevents [setPath] from writing back the values of the path, see [convertMany].
+var errDryRun = errors.New("dry run") //nolint:gochecknoglobals
+
+// convertMany converts the given values to types of their fields, the struct is not changed.
+func convertMany(strct any, fields []string, vals map[string]any, opts Options) (map[string]reflect.Value, error) {
+	var (
+		r    = make(map[string]reflect.Value, len(fields))
+		errs = make([]error, 0, len(fields))
+	)
+
+	for _, f := range fields {
+		f := f
+
+		err := setField(strct, f, opts, func(elem reflect.Value) error {
+			v, err := ValueOfWith(vals[f], elem.Type(), opts)
+			if err != nil {
+				return err
+			}
+
+			r[f] = v
+
+			return errDryRun
+		})
+
+		if _, ok := r[f]; !ok {
+			errs = append(errs, err)
+		}
+	}
+
+	return r, grouperror.Join(errs...) //nolint:wrapcheck
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	stdReflect "reflect"
+	"sync"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	manyPool struct {
+		MaxConns int
+		Labels   map[string]string
+	}
+
+	manyConfig struct {
+		Pool    *manyPool
+		Servers []string
+		Any     any
+		Self    *manyConfig
+		name    string
+		timeout int
+	}
+)
+
+func newManyConfig() *manyConfig {
+	cfg := &manyConfig{
+		Pool:    &manyPool{MaxConns: 10, Labels: map[string]string{"env": "dev"}},
+		Servers: []string{"localhost"},
+		Any:     manyPool{MaxConns: 1},
+		name:    "db",
+		timeout: 30,
+	}
+	cfg.Self = cfg
+
+	return cfg
+}
+
+func TestSetMany(t *testing.T) {
+	t.Parallel()
+
+	vals := map[string]any{
+		"Pool.MaxConns":      "20",
+		`Pool.Labels["env"]`: "prod",
+		"Servers[1]":         "remote",
+		"Any.MaxConns":       2,
+		"name":               "main",
+	}
+
+	for _, atomic := range []bool{false, true} {
+		atomic := atomic
+
+		name := "OK"
+		if atomic {
+			name += " (atomic)"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := newManyConfig()
+			require.NoError(t, reflect.SetMany(&cfg, vals, reflect.DefaultOptions(), atomic))
+
+			assert.Equal(t, &manyPool{MaxConns: 20, Labels: map[string]string{"env": "prod"}}, cfg.Pool)
+			assert.Equal(t, []string{"localhost", "remote"}, cfg.Servers)
+			assert.Equal(t, manyPool{MaxConns: 2}, cfg.Any)
+			assert.Equal(t, "main", cfg.name)
+			assert.Same(t, cfg, cfg.Self)
+		})
+	}
+
+	invalid := map[string]any{
+		"timeout":            "thirty",
+		"Pool.MaxConns":      "20",
+		`Pool.Labels["env"]`: "prod",
+		"Servers[1]":         "remote",
+		"Any.MaxConns":       2,
+		"Port":               8080,
+	}
+
+	expectedErrs := []string{
+		`set (*reflect_test.manyConfig)."Port": field "Port" does not exist`,
+		`set (*reflect_test.manyConfig)."timeout": cannot convert string to int: parsing "thirty": invalid syntax`,
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := newManyConfig()
+		err := reflect.SetMany(cfg, invalid, reflect.DefaultOptions(), false)
+
+		errs := grouperror.Collection(err)
+		require.Len(t, errs, len(expectedErrs))
+
+		for i, e := range expectedErrs {
+			assert.EqualError(t, errs[i], e)
+		}
+
+		assert.Equal(t, 20, cfg.Pool.MaxConns)
+		assert.Equal(t, "prod", cfg.Pool.Labels["env"])
+		assert.Equal(t, []string{"localhost", "remote"}, cfg.Servers)
+		assert.Equal(t, 30, cfg.timeout)
+	})
+
+	t.Run("Errors (atomic)", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := newManyConfig()
+		pool, labels, servers := cfg.Pool, cfg.Pool.Labels, cfg.Servers
+
+		err := reflect.SetMany(cfg, invalid, reflect.DefaultOptions(), true)
+
+		errs := grouperror.Collection(err)
+		require.Len(t, errs, len(expectedErrs))
+
+		for i, e := range expectedErrs {
+			assert.EqualError(t, errs[i], e)
+		}
+
+		expected := newManyConfig()
+		expected.Self = cfg
+		assert.Equal(t, expected, cfg)
+		assert.Same(t, pool, cfg.Pool)
+		assert.Equal(t, map[string]string{"env": "dev"}, labels)
+		assert.Equal(t, []string{"localhost"}, servers)
+	})
+
+	t.Run("Converters are called once (atomic)", func(t *testing.T) {
+		t.Parallel()
+
+		type port int
+
+		calls := 0
+		toPort := func(from stdReflect.Value, to stdReflect.Type, _ func(any, stdReflect.Type) (stdReflect.Value, error)) (
+			stdReflect.Value,
+			bool,
+			error,
+		) {
+			if to != stdReflect.TypeOf(port(0)) {
+				return stdReflect.Value{}, false, nil
+			}
+
+			calls++
+
+			return stdReflect.ValueOf(port(from.Len())), true, nil
+		}
+
+		cfg := struct {
+			Ports []port
+			mu    *sync.Mutex
+		}{
+			mu: &sync.Mutex{},
+		}
+		mu := cfg.mu
+
+		opts := reflect.DefaultOptions()
+		opts.BeforeBuiltIn = []reflect.ConverterFunc{toPort}
+
+		err := reflect.SetMany(&cfg, map[string]any{"Ports[0]": "http", "Ports[1]": "https"}, opts, true)
+		require.NoError(t, err)
+		assert.Equal(t, []port{4, 5}, cfg.Ports)
+		assert.Equal(t, 2, calls)
+		assert.Same(t, mu, cfg.mu)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		err := reflect.SetMany(nil, map[string]any{"Name": "Mary"}, reflect.DefaultOptions(), true)
+		assert.EqualError(t, err, `set (<nil>)."Name": expected pointer to struct, <nil> given`)
+	})
+}
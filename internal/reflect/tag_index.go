@@ -0,0 +1,243 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gontainer/grouperror"
+)
+
+//nolint:gochecknoglobals
+var (
+	tagIndexes sync.Map // map[tagIndexKey]tagIndex
+)
+
+type tagIndexKey struct {
+	t      reflect.Type
+	tagKey string
+}
+
+// tagIndex maps names given in tags to fields.
+type tagIndex map[string]tagField
+
+type tagField struct {
+	path Path
+	err  error
+}
+
+// GetByTag works similar to [Get], but it finds the field by the name given in the struct tag `tagKey`,
+// see [buildTagIndex].
+func GetByTag(strct any, tagKey string, name string) (_ any, err error) { //nolint:ireturn
+	defer func() {
+		if err != nil {
+			err = grouperror.Prefix(fmt.Sprintf("get (%T) by tag %s: ", strct, formatTag(tagKey, name)), err)
+		}
+	}()
+
+	return get(strct, tagPath(tagKey, name), MatchExact)
+}
+
+// SetByTag works similar to [SetWith], but it finds the field by the name given in the struct tag `tagKey`.
+func SetByTag(strct any, tagKey string, name string, val any, opts Options) (err error) {
+	defer func() {
+		if err != nil {
+			err = grouperror.Prefix(fmt.Sprintf("set (%T) by tag %s: ", strct, formatTag(tagKey, name)), err)
+		}
+	}()
+
+	return set(strct, tagPath(tagKey, name), opts, assignValue(val, opts))
+}
+
+func formatTag(tagKey string, name string) string {
+	return fmt.Sprintf("%s:%+q", tagKey, name)
+}
+
+func tagPath(tagKey string, name string) pathResolver {
+	return func(t reflect.Type) (Path, error) {
+		f, ok := cachedTagIndex(t, tagKey)[name]
+		if !ok {
+			return nil, fmt.Errorf("field with tag %s does not exist", formatTag(tagKey, name))
+		}
+
+		return f.path, f.err
+	}
+}
+
+// cachedTagIndex returns [buildTagIndex] cached per type and tag key.
+func cachedTagIndex(t reflect.Type, tagKey string) tagIndex {
+	key := tagIndexKey{t: t, tagKey: tagKey}
+
+	if r, ok := tagIndexes.Load(key); ok {
+		return r.(tagIndex) //nolint:forcetypeassert
+	}
+
+	r := buildTagIndex(t, tagKey)
+	tagIndexes.Store(key, r)
+
+	return r
+}
+
+// parseTag returns the name given in the tag, and skips options, e.g. `omitempty`.
+func parseTag(tag string) string {
+	if i := strings.IndexByte(tag, ','); i != -1 {
+		return tag[:i]
+	}
+
+	return tag
+}
+
+type taggedField struct {
+	name   string
+	tagged bool
+	path   Path
+}
+
+type embeddedStruct struct {
+	t    reflect.Type
+	path Path
+}
+
+/*
+buildTagIndex indexes fields of the given struct by names given in the tag `tagKey`
+following the rules of [encoding/json]:
+
+  - the tag "-" excludes the field,
+  - fields without a name in the tag are indexed by their names,
+  - fields of embedded structs without a name in the tag are promoted,
+  - the shallowest field wins, then the tagged one; other conflicts make the name ambiguous.
+
+Unlike [encoding/json], unexported fields are indexed whenever the tag gives them a name.
+*/
+func buildTagIndex(t reflect.Type, tagKey string) tagIndex { //nolint:cyclop
+	var (
+		fields  []taggedField
+		next    = []embeddedStruct{{t: t}}
+		visited = make(map[reflect.Type]struct{})
+	)
+
+	for len(next) > 0 {
+		current := next
+		next = nil
+
+		for _, e := range current {
+			// embedded structs of the same type at the same depth are indexed separately, their fields conflict
+			if _, ok := visited[e.t]; ok {
+				continue
+			}
+
+			for i := 0; i < e.t.NumField(); i++ {
+				sf := e.t.Field(i)
+				exported := sf.PkgPath == ""
+
+				tag := sf.Tag.Get(tagKey)
+				if tag == "-" {
+					continue
+				}
+
+				name := parseTag(tag)
+
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+
+				path := append(e.path[:len(e.path):len(e.path)], PathSegment{Kind: StructField, Field: sf.Name})
+
+				if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+					next = append(next, embeddedStruct{t: ft, path: path})
+
+					continue
+				}
+
+				if !exported && name == "" {
+					continue
+				}
+
+				f := taggedField{name: name, tagged: name != "", path: path}
+				if f.name == "" {
+					f.name = sf.Name
+				}
+
+				fields = append(fields, f)
+			}
+		}
+
+		for _, e := range current {
+			visited[e.t] = struct{}{}
+		}
+	}
+
+	return newTagIndex(fields)
+}
+
+func newTagIndex(fields []taggedField) tagIndex {
+	sort.SliceStable(fields, func(i, j int) bool {
+		if fields[i].name != fields[j].name {
+			return fields[i].name < fields[j].name
+		}
+
+		if len(fields[i].path) != len(fields[j].path) {
+			return len(fields[i].path) < len(fields[j].path)
+		}
+
+		return fields[i].tagged && !fields[j].tagged
+	})
+
+	r := make(tagIndex)
+
+	for i := 0; i < len(fields); {
+		j := i + 1
+		for j < len(fields) && fields[j].name == fields[i].name {
+			j++
+		}
+
+		r[fields[i].name] = dominantField(fields[i:j])
+		i = j
+	}
+
+	return r
+}
+
+// dominantField returns the field that wins, the given fields are sorted by depth and tags.
+func dominantField(fields []taggedField) tagField {
+	first := fields[0]
+
+	var conflicts []string
+
+	for _, f := range fields {
+		if len(f.path) != len(first.path) || f.tagged != first.tagged {
+			break
+		}
+
+		conflicts = append(conflicts, strings.TrimPrefix(f.path.String(), "."))
+	}
+
+	if len(conflicts) > 1 {
+		return tagField{err: fmt.Errorf("many fields match: %s", strings.Join(conflicts, ", "))}
+	}
+
+	return tagField{path: first.path}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reflect_test
+
+import (
+	"testing"
+
+	"github.com/gontainer/reflectpro/internal/reflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	tagAudit struct {
+		CreatedBy string `yaml:"created_by,omitempty"`
+		UpdatedBy string `yaml:"updated_by"`
+		Version   int
+	}
+
+	tagMeta struct {
+		Version int    `yaml:"version"`
+		Owner   string `yaml:"owner"`
+	}
+
+	tagLabels struct {
+		Owner string `yaml:"owner"`
+	}
+
+	tagUser struct {
+		*tagAudit
+		tagMeta
+		tagLabels
+		ID       int    `yaml:"id"`
+		Name     string `yaml:"user_name,omitempty"`
+		Password string `yaml:"-"`
+		Dash     string `yaml:"-,"`
+		Email    string
+		secret   string `yaml:"secret"`
+		internal string
+	}
+)
+
+func TestGetByTag(t *testing.T) {
+	t.Parallel()
+
+	u := tagUser{
+		tagAudit:  &tagAudit{CreatedBy: "admin", Version: 1},
+		tagMeta:   tagMeta{Version: 2, Owner: "meta"},
+		tagLabels: tagLabels{Owner: "labels"},
+		ID:        7,
+		Name:      "Mary",
+		Password:  "password",
+		Dash:      "dash",
+		Email:     "mary@example.com",
+		secret:    "secret",
+		internal:  "internal",
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		scenarios := map[string]any{
+			"id":         7,
+			"user_name":  "Mary",
+			"-":          "dash",
+			"Email":      "mary@example.com",
+			"secret":     "secret",
+			"created_by": "admin",
+			"version":    2, // the tagged field wins
+		}
+
+		for name, expected := range scenarios {
+			name, expected := name, expected
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				for _, strct := range []any{u, &u} {
+					v, err := reflect.GetByTag(strct, "yaml", name)
+					require.NoError(t, err)
+					assert.Equal(t, expected, v)
+				}
+			})
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		scenarios := map[string]string{
+			"Password": `get (reflect_test.tagUser) by tag yaml:"Password": field with tag yaml:"Password" does not exist`,
+			"Name":     `get (reflect_test.tagUser) by tag yaml:"Name": field with tag yaml:"Name" does not exist`,
+			"internal": `get (reflect_test.tagUser) by tag yaml:"internal": field with tag yaml:"internal" does not exist`,
+			"owner": `get (reflect_test.tagUser) by tag yaml:"owner": ` +
+				`many fields match: tagMeta.Owner, tagLabels.Owner`,
+		}
+
+		for name, expected := range scenarios {
+			name, expected := name, expected
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := reflect.GetByTag(u, "yaml", name)
+				assert.EqualError(t, err, expected)
+			})
+		}
+	})
+	t.Run("Nil embedded struct", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reflect.GetByTag(tagUser{}, "yaml", "created_by")
+		assert.EqualError(
+			t,
+			err,
+			`get (reflect_test.tagUser) by tag yaml:"created_by": tagAudit.CreatedBy: unexpected nil *reflect_test.tagAudit`,
+		)
+	})
+}
+
+func TestSetByTag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		var u tagUser
+
+		require.NoError(t, reflect.SetByTag(&u, "yaml", "user_name", "Mary", reflect.Options{}))
+		require.NoError(t, reflect.SetByTag(&u, "yaml", "id", "7", reflect.Options{}))
+		require.NoError(t, reflect.SetByTag(&u, "yaml", "secret", "secret", reflect.Options{}))
+		require.NoError(t, reflect.SetByTag(&u, "yaml", "updated_by", "admin", reflect.Options{}))
+
+		assert.Equal(
+			t,
+			tagUser{tagAudit: &tagAudit{UpdatedBy: "admin"}, ID: 7, Name: "Mary", secret: "secret"},
+			u,
+		)
+	})
+	t.Run("Interface", func(t *testing.T) {
+		t.Parallel()
+
+		var u any = tagUser{}
+
+		require.NoError(t, reflect.SetByTag(&u, "yaml", "Email", "jane@example.com", reflect.Options{}))
+		assert.Equal(t, tagUser{Email: "jane@example.com"}, u)
+	})
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		var u tagUser
+
+		err := reflect.SetByTag(&u, "yaml", "id", "seven", reflect.Options{})
+		assert.EqualError(
+			t,
+			err,
+			`set (*reflect_test.tagUser) by tag yaml:"id": cannot convert string to int: parsing "seven": invalid syntax`,
+		)
+
+		err = reflect.SetByTag(&u, "yaml", "id", "7", reflect.Options{AssignOnly: true})
+		assert.EqualError(
+			t,
+			err,
+			`set (*reflect_test.tagUser) by tag yaml:"id": value of type string is not assignable to type int`,
+		)
+
+		err = reflect.SetByTag(&u, "yaml", "owner", "admin", reflect.Options{})
+		assert.EqualError(
+			t,
+			err,
+			`set (*reflect_test.tagUser) by tag yaml:"owner": many fields match: tagMeta.Owner, tagLabels.Owner`,
+		)
+		assert.Equal(t, tagUser{}, u)
+	})
+}
+
+func TestGetByTag_recursive(t *testing.T) {
+	t.Parallel()
+
+	type node struct {
+		*node
+		Value int `yaml:"value"`
+	}
+
+	v, err := reflect.GetByTag(node{Value: 5}, "yaml", "value")
+	require.NoError(t, err)
+	assert.Equal(t, 5, v)
+}
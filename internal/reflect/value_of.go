@@ -33,15 +33,21 @@ ValueOf for the `i == nil` and a nil-able [reflect.Kind] of `to` returns a zero
 
 If the third argument equals true, it converts the type whenever it is possible,
 If `result.Type()` is not assignable to `to` it returns an error.
+It applies [DefaultOptions], see [ValueOfWith].
 */
 func ValueOf(i any, to reflect.Type, convertVal bool) (reflect.Value, error) {
-	if convertVal {
-		return convert(i, to)
+	return ValueOfWith(i, to, ConvertOptions(convertVal))
+}
+
+// ValueOfWith works similar to [ValueOf], but it applies the given options instead of [DefaultOptions].
+func ValueOfWith(i any, to reflect.Type, opts Options) (reflect.Value, error) {
+	if !opts.AssignOnly {
+		return convert(i, to, opts)
 	}
 
 	r := reflect.ValueOf(i)
 	if !r.IsValid() {
-		return zeroForNilable(i, to)
+		return zeroForNil(i, to, opts.NilAsZero)
 	}
 
 	if err := assignable(r.Type(), to); err != nil {
@@ -51,12 +57,14 @@ func ValueOf(i any, to reflect.Type, convertVal bool) (reflect.Value, error) {
 	return r, nil
 }
 
-func zeroForNilable(i any, t reflect.Type) (reflect.Value, error) {
-	if i == nil && isNilable(t.Kind()) {
+// zeroForNil returns the zero value of the type `t` for the untyped nil.
+// Unless anyType equals true, `t` must be of a nil-able kind.
+func zeroForNil(i any, t reflect.Type, anyType bool) (reflect.Value, error) {
+	if i == nil && (anyType || isNilable(t.Kind())) {
 		return reflect.Zero(t), nil
 	}
 
-	return reflect.Value{}, fmt.Errorf("cannot convert %T to %s", i, t.String())
+	return reflect.Value{}, &ConversionError{From: reflect.TypeOf(i), To: t}
 }
 
 func isNilable(k reflect.Kind) bool {
@@ -180,9 +180,9 @@ func TestValueOf(t *testing.T) {
 					error: "cannot convert map[string]int32 to map[string]struct {}: non convertible values: cannot convert int32 to struct {}", //nolint:lll
 				},
 				{
-					input: map[string]any{"pi": "3.14"},
+					input: map[string]any{"pi": "three point one four"},
 					to:    reflect.TypeOf((map[string]float64)(nil)),
-					error: "cannot convert map[string]interface {} to map[string]float64: map value: cannot convert string to float64",
+					error: `cannot convert map[string]interface {} to map[string]float64: map value: cannot convert string to float64: parsing "three point one four": invalid syntax`, //nolint:lll
 				},
 				{
 					input: map[any]any{true: "true"},
@@ -398,13 +398,15 @@ func TestValueOf(t *testing.T) {
 			},
 			`*float64 to *int`: {
 				input:  &float64Val,
-				output: (*int)(nil),
-				error:  "cannot convert *float64 to *int",
+				output: func() *int { i := 5; return &i }(), //nolint:nlreturn
 			},
 			`*float64 to *float32`: {
 				input:  &float64Val,
-				output: (*float32)(nil),
-				error:  "cannot convert *float64 to *float32",
+				output: func() *float32 { f := float32(5); return &f }(), //nolint:nlreturn
+			},
+			`nil *float64 to *int`: {
+				input:  (*float64)(nil),
+				output: (*int)(nil),
 			},
 			`*float64 to *float64`: {
 				input:  &float64Val,
@@ -422,14 +424,18 @@ func TestValueOf(t *testing.T) {
 				input:  []byte(`hello`),
 				output: `hello`,
 			},
-			`string to int`: { // cannot convert string to int
+			`string to int`: {
 				input:  `5`,
 				output: int(5),
-				error:  "cannot convert string to int",
 			},
-			`int to string`: { // but reverse conversion is possible, isn't worth to unify this behavior?
+			`string to int (invalid)`: {
+				input:  `five`,
+				output: int(0),
+				error:  `cannot convert string to int: parsing "five": invalid syntax`,
+			},
+			`int to string`: {
 				input:  5,
-				output: "\x05",
+				output: "5",
 			},
 			`nil to *any`: {
 				input:  nil,
@@ -455,3 +461,73 @@ func TestValueOf(t *testing.T) {
 		}
 	})
 }
+
+func TestValueOfWith(t *testing.T) {
+	t.Parallel()
+
+	t.Run("AssignOnly", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := intReflect.ValueOfWith(5, reflect.TypeOf(uint(0)), intReflect.Options{AssignOnly: true})
+		assert.EqualError(t, err, "value of type int is not assignable to type uint")
+
+		v, err := intReflect.ValueOfWith(5, reflect.TypeOf(uint(0)), intReflect.Options{})
+		require.NoError(t, err)
+		assert.Equal(t, uint(5), v.Interface())
+	})
+	t.Run("NilAsZero", func(t *testing.T) {
+		t.Parallel()
+
+		for _, assignOnly := range []bool{false, true} {
+			opts := intReflect.Options{AssignOnly: assignOnly}
+
+			_, err := intReflect.ValueOfWith(nil, reflect.TypeOf(0), opts)
+			assert.EqualError(t, err, "cannot convert <nil> to int")
+
+			opts.NilAsZero = true
+
+			v, err := intReflect.ValueOfWith(nil, reflect.TypeOf(0), opts)
+			require.NoError(t, err)
+			assert.Equal(t, 0, v.Interface())
+		}
+
+		v, err := intReflect.ValueOfWith([]any{1, nil}, reflect.TypeOf([]int{}), intReflect.Options{NilAsZero: true})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 0}, v.Interface())
+	})
+	t.Run("Custom converters", func(t *testing.T) {
+		t.Parallel()
+
+		type celsius float64
+
+		double := func(from reflect.Value, to reflect.Type, _ func(any, reflect.Type) (reflect.Value, error)) (
+			reflect.Value,
+			bool,
+			error,
+		) {
+			if from.Kind() != reflect.Int || to != reflect.TypeOf(celsius(0)) {
+				return reflect.Value{}, false, nil
+			}
+
+			return reflect.ValueOf(celsius(from.Int() * 2)), true, nil
+		}
+
+		opts := intReflect.Options{BeforeBuiltIn: []intReflect.ConverterFunc{double}}
+
+		v, err := intReflect.ValueOfWith([]int{1, 2}, reflect.TypeOf([]celsius{}), opts)
+		require.NoError(t, err)
+		assert.Equal(t, []celsius{2, 4}, v.Interface())
+
+		// the converter applies to the given conversion only
+		v, err = intReflect.ValueOfWith([]int{1, 2}, reflect.TypeOf([]celsius{}), intReflect.Options{})
+		require.NoError(t, err)
+		assert.Equal(t, []celsius{1, 2}, v.Interface())
+
+		// built-in converters take precedence over the ones applied after them
+		opts = intReflect.Options{AfterBuiltIn: []intReflect.ConverterFunc{double}}
+
+		v, err = intReflect.ValueOfWith(3, reflect.TypeOf(celsius(0)), opts)
+		require.NoError(t, err)
+		assert.Equal(t, celsius(3), v.Interface())
+	})
+}
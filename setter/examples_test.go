@@ -22,7 +22,11 @@ package setter_test
 
 import (
 	"fmt"
+	"net"
 
+	"github.com/gontainer/grouperror"
+	"github.com/gontainer/reflectpro/converter"
+	"github.com/gontainer/reflectpro/getter"
 	"github.com/gontainer/reflectpro/setter"
 )
 
@@ -87,6 +91,18 @@ func ExampleSet_typeMismatchingConvert() {
 	// Output: Jane
 }
 
+func ExampleSet_textUnmarshaler() {
+	type Config struct {
+		Addr net.IP
+	}
+
+	var cfg Config
+
+	_ = setter.Set(&cfg, "Addr", "10.0.0.1", true)
+	fmt.Println(cfg.Addr.Equal(net.IPv4(10, 0, 0, 1)))
+	// Output: true
+}
+
 //nolint:gosimple
 func ExampleSet_unaddressableValue() {
 	var person any
@@ -104,3 +120,149 @@ func ExampleSet_unaddressableValue() {
 	// Output:
 	// {Jane Doe}
 }
+
+func ExampleSetWith() {
+	person := struct {
+		Name string
+		Age  int
+	}{Name: "Mary", Age: 30}
+	err := setter.SetWith(&person, "Age", nil, converter.NilAsZero())
+	fmt.Printf("%+v\n", person)
+	fmt.Println(err)
+	// Output:
+	// {Name:Mary Age:0}
+	// <nil>
+}
+
+func ExampleSetWith_matchFieldNames() {
+	var cfg struct {
+		Database struct {
+			MaxConns int
+		}
+	}
+	err := setter.SetWith(&cfg, "database.max_conns", "10", converter.MatchFieldNames(converter.MatchNormalized))
+	fmt.Println(cfg.Database.MaxConns)
+	fmt.Println(err)
+	// Output:
+	// 10
+	// <nil>
+}
+
+//nolint:lll
+func ExampleSet_path() {
+	type (
+		Server struct {
+			Host  string
+			Ports map[string]int
+		}
+		Config struct {
+			Servers []*Server
+		}
+	)
+
+	var cfg Config
+
+	err1 := setter.Set(&cfg, "Servers[1].Host", "localhost", false)
+	err2 := setter.Set(&cfg, `Servers[1].Ports["http"]`, "80", true)
+	err3 := setter.Set(&cfg, `Servers[1].Ports["https"]`, "443s", true)
+
+	fmt.Println(cfg.Servers[0])
+	fmt.Printf("%+v\n", *cfg.Servers[1])
+	fmt.Println(err1, err2)
+	fmt.Println(err3)
+
+	// Output:
+	// <nil>
+	// {Host:localhost Ports:map[http:80]}
+	// <nil> <nil>
+	// set (*setter_test.Config)."Servers[1].Ports[\"https\"]": Servers[1].Ports["https"]: cannot convert string to int: parsing "443s": invalid syntax
+}
+
+func ExampleSetByTag() {
+	type Config struct {
+		MaxConns int `env:"MAX_CONNS,required"`
+	}
+
+	var cfg Config
+
+	err := setter.SetByTag(&cfg, "env", "MAX_CONNS", "10", true)
+
+	fmt.Printf("%+v\n", cfg)
+	fmt.Println(err)
+
+	// Output:
+	// {MaxConns:10}
+	// <nil>
+}
+
+//nolint:lll
+func ExampleSetMany() {
+	var cfg struct {
+		Host    string
+		Port    int
+		Timeout int
+	}
+
+	err := setter.SetMany(&cfg, map[string]any{"Host": "localhost", "Port": "http", "Timeout": "thirty"}, true)
+
+	fmt.Println(cfg.Host)
+
+	for _, e := range grouperror.Collection(err) {
+		fmt.Println(e)
+	}
+
+	// Output:
+	// localhost
+	// set (*struct { Host string; Port int; Timeout int })."Port": cannot convert string to int: parsing "http": invalid syntax
+	// set (*struct { Host string; Port int; Timeout int })."Timeout": cannot convert string to int: parsing "thirty": invalid syntax
+}
+
+func ExampleSetManyAtomic() {
+	var cfg struct {
+		Host string
+		Port int
+	}
+
+	err := setter.SetManyAtomic(&cfg, map[string]any{"Host": "localhost", "Port": "http"}, true)
+	fmt.Printf("%q\n", cfg.Host)
+	fmt.Println(err)
+
+	err = setter.SetManyAtomic(&cfg, map[string]any{"Host": "localhost", "Port": "8080"}, true)
+	fmt.Printf("%q %d\n", cfg.Host, cfg.Port)
+	fmt.Println(err)
+
+	// Output:
+	// ""
+	// set (*struct { Host string; Port int })."Port": cannot convert string to int: parsing "http": invalid syntax
+	// "localhost" 8080
+	// <nil>
+}
+
+func ExampleSetByIndex() {
+	type (
+		Base struct {
+			Name string
+		}
+		User struct {
+			*Base
+			Name string
+			_    int32
+		}
+	)
+
+	var u User
+
+	_ = setter.SetByIndex(&u, []int{1}, "Mary", false)
+	_ = setter.SetByIndex(&u, []int{0, 0}, "base", false)
+	_ = setter.SetByIndex(&u, []int{2}, "7", true)
+	blank, _ := getter.GetByIndex(u, []int{2})
+
+	fmt.Println(u.Name)
+	fmt.Println(u.Base.Name)
+	fmt.Println(blank)
+
+	// Output:
+	// Mary
+	// base
+	// 7
+}
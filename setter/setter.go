@@ -21,6 +21,7 @@
 package setter
 
 import (
+	"github.com/gontainer/reflectpro/converter"
 	"github.com/gontainer/reflectpro/internal/reflect"
 )
 
@@ -29,13 +30,106 @@ Set assigns the value `val` to the field `field` on the struct `strct`.
 If the fourth argument equals true, it converts the type whenever it is possible.
 Unexported fields are supported.
 
+The field can be a path to a nested value, e.g. `Database.Pool.MaxConns`, `Servers[2].Host` or `Labels["env"]`,
+see [getter.Get]. Nil pointers, maps and slices along the path are allocated,
+and slices are grown whenever the index exceeds their length.
+The struct is not changed unless the value is assigned successfully.
+
 	type Person struct {
 		Name string
 	}
 	p := Person{}
 	_ = setter.Set(&p, "Name", "Jane", false)
 	fmt.Println(p) // {Jane}
+
+[getter.Get]: https://pkg.go.dev/github.com/gontainer/reflectpro/getter#Get
 */
 func Set(strct any, field string, val any, convert bool) error {
 	return reflect.Set(strct, field, val, convert)
 }
+
+/*
+SetByTag works similar to [Set], but it finds the field by the name given in the struct tag `tagKey`,
+see [getter.GetByTag]. Nil pointers to embedded structs are allocated.
+
+	type User struct {
+		Name string `json:"user_name,omitempty"`
+	}
+	u := User{}
+	_ = setter.SetByTag(&u, "json", "user_name", "Mary", false)
+	fmt.Println(u) // {Mary}
+
+[getter.GetByTag]: https://pkg.go.dev/github.com/gontainer/reflectpro/getter#GetByTag
+*/
+func SetByTag(strct any, tagKey string, name string, val any, convert bool) error {
+	return reflect.SetByTag(strct, tagKey, name, val, reflect.ConvertOptions(convert))
+}
+
+/*
+SetByIndex works similar to [Set], but it finds the field by the index sequence, see [getter.GetByIndex].
+Blank fields and fields shadowed by promoted ones are supported. Nil pointers to embedded structs are allocated.
+
+	type Padded struct {
+		A int32
+		_ int32
+	}
+	p := Padded{}
+	_ = setter.SetByIndex(&p, []int{1}, 5, true)
+	v, _ := getter.GetByIndex(p, []int{1})
+	fmt.Println(v) // 5
+
+[getter.GetByIndex]: https://pkg.go.dev/github.com/gontainer/reflectpro/getter#GetByIndex
+*/
+func SetByIndex(strct any, index []int, val any, convert bool) error {
+	return reflect.SetByIndex(strct, index, val, reflect.ConvertOptions(convert))
+}
+
+/*
+SetMany assigns the given values to the given fields, or paths, see [Set].
+Fields are set in the lexicographical order, and all failures are returned together,
+see [grouperror.Collection]. Successfully assigned values remain assigned, see [SetManyAtomic].
+
+	var cfg struct {
+		Host string
+		Port int
+	}
+	err := setter.SetMany(&cfg, map[string]any{"Host": "localhost", "Port": "8080"}, true)
+	fmt.Println(cfg, err) // {localhost 8080} <nil>
+
+[grouperror.Collection]: https://pkg.go.dev/github.com/gontainer/grouperror#Collection
+*/
+func SetMany(strct any, vals map[string]any, convert bool) error {
+	return reflect.SetMany(strct, vals, reflect.ConvertOptions(convert), false)
+}
+
+/*
+SetManyAtomic works similar to [SetMany], but the struct is not changed unless all the values are assigned successfully.
+All values are converted to types of their fields before any of them is assigned.
+
+	var cfg struct {
+		Host string
+		Port int
+	}
+	err := setter.SetManyAtomic(&cfg, map[string]any{"Host": "localhost", "Port": "http"}, true)
+	fmt.Println(cfg.Host == "") // true
+	fmt.Println(err)            // set (*struct { Host string; Port int })."Port": cannot convert string to int: ...
+*/
+func SetManyAtomic(strct any, vals map[string]any, convert bool) error {
+	return reflect.SetMany(strct, vals, reflect.ConvertOptions(convert), true)
+}
+
+/*
+SetWith works similar to [Set], but it accepts options of the conversion.
+The given options are applied on top of the defaults, see [converter.SetDefaults].
+It converts the value whenever possible, unless [converter.AssignOnly] is given.
+
+	type Person struct {
+		Age int
+	}
+	p := Person{}
+	_ = setter.SetWith(&p, "Age", nil, converter.NilAsZero())
+	fmt.Println(p) // {0}
+*/
+func SetWith(strct any, field string, val any, opts ...converter.Option) error {
+	return reflect.SetWith(strct, field, val, reflect.NewOptions(opts...))
+}